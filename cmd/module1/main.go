@@ -1,10 +1,19 @@
 package main
 
 import (
+	"os"
+
 	"github.com/hrvadl/algo/internal/cli"
 )
 
+const serveMode = "serve"
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == serveMode {
+		serve(os.Args[2:])
+		return
+	}
+
 	cli.PrintStudentInfo()
 	go cli.Start()
 	cli.HandleGracefulShutdown()
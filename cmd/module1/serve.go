@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/hrvadl/algo/internal/api"
+	"github.com/hrvadl/algo/internal/cli"
+	"github.com/hrvadl/algo/internal/rpc"
+)
+
+func serve(args []string) {
+	cfg := api.DefaultConfig()
+	fs := flag.NewFlagSet(serveMode, flag.ExitOnError)
+	fs.StringVar(&cfg.Addr, "addr", cfg.Addr, "address to listen on")
+	fs.Int64Var(&cfg.MaxBodyBytes, "max-body", cfg.MaxBodyBytes, "max request body size in bytes")
+	fs.IntVar(&cfg.MaxMatrixSize, "max-matrix", cfg.MaxMatrixSize, "max amount of matrix rows and columns")
+	fs.IntVar(&cfg.MaxSimulations, "max-simulations", cfg.MaxSimulations, "max amount of simulated rounds")
+	fs.IntVar(&cfg.MaxConcurrent, "max-concurrent", cfg.MaxConcurrent, "max amount of requests solved at once")
+	fs.DurationVar(&cfg.RequestTimeout, "timeout", cfg.RequestTimeout, "solver timeout per request")
+	grpcAddr := fs.String("grpc-addr", "", "address to serve gRPC on, disabled when empty")
+	openapi := fs.Bool("openapi", false, "print OpenAPI document and exit")
+	_ = fs.Parse(args)
+
+	if *openapi {
+		_, _ = os.Stdout.Write(api.OpenAPI())
+		return
+	}
+
+	srv := api.NewServer(cfg)
+	go func() {
+		fmt.Printf("Listening on %s\n", cfg.Addr)
+		if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			cli.PrintError(err)
+			os.Exit(1)
+		}
+	}()
+
+	var grpcSrv *grpc.Server
+	if *grpcAddr != "" {
+		lis, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			cli.PrintError(err)
+			os.Exit(1)
+		}
+
+		grpcSrv = rpc.NewGRPCServer(rpc.Config{
+			MaxMatrixSize:  cfg.MaxMatrixSize,
+			MaxSimulations: cfg.MaxSimulations,
+			MaxConcurrent:  cfg.MaxConcurrent,
+			RequestTimeout: cfg.RequestTimeout,
+		})
+		go func() {
+			fmt.Printf("Serving gRPC on %s\n", *grpcAddr)
+			if err := grpcSrv.Serve(lis); err != nil {
+				cli.PrintError(err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+	cli.HandleGracefulShutdown()
+	if grpcSrv != nil {
+		stopGRPC(grpcSrv)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cli.GracefulShutdownTime)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		cli.PrintError(err)
+	}
+	cli.PrintExitMessage()
+}
+
+// stopGRPC waits for running rpcs up to GracefulShutdownTime.
+func stopGRPC(s *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(cli.GracefulShutdownTime):
+		s.Stop()
+	}
+}
@@ -0,0 +1,250 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
+
+	"github.com/hrvadl/algo/internal/games"
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/workspace"
+)
+
+func (s *Server) handleInverse(ctx context.Context, r *http.Request) (any, error) {
+	var req MatrixRequest
+	if err := decode(r.Body, &req); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateMatrix(req.Matrix); err != nil {
+		return nil, err
+	}
+
+	m := req.Matrix.toMatrix()
+	if cols, rows := m.GetDimensions(); cols != rows {
+		return nil, badRequest(errors.New("matrix should be square"))
+	}
+
+	inverted, err := m.Invert(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return MatrixResponse{Matrix: fromMatrix(inverted)}, nil
+}
+
+func (s *Server) handleSolveLP(ctx context.Context, r *http.Request) (any, error) {
+	var req LPRequest
+	if err := decode(r.Body, &req); err != nil {
+		return nil, err
+	}
+
+	if len(req.Constraints) == 0 || len(req.Constraints) > s.cfg.MaxMatrixSize {
+		return nil, badRequest(fmt.Errorf("amount of constraints should be in [1; %d]", s.cfg.MaxMatrixSize))
+	}
+
+	if req.Integer && req.Doubled {
+		return nil, badRequest(errors.New("problem cannot be both integer and doubled"))
+	}
+
+	if req.Bounded && (req.Integer || req.Doubled) {
+		return nil, badRequest(errors.New("bounded problem cannot be integer or doubled"))
+	}
+
+	if req.Interior && (req.Integer || req.Doubled || req.Bounded) {
+		return nil, badRequest(errors.New("interior point method solves only continuous problems"))
+	}
+
+	problem, err := workspace.Model{
+		Objective:   req.Objective,
+		Constraints: req.Constraints,
+		Bounds:      req.Bounds,
+		Goal:        req.Goal,
+	}.Problem()
+	if err != nil {
+		return nil, badRequest(err)
+	}
+
+	compile := problem.Compile
+	if req.Bounded {
+		compile = problem.CompileBounded
+	}
+
+	tableau, err := compile()
+	if err != nil {
+		return nil, badRequest(err)
+	}
+
+	if len(tableau.Matrix.Rows[0]) > s.cfg.MaxMatrixSize+1 {
+		return nil, badRequest(fmt.Errorf("amount of variables should not exceed %d", s.cfg.MaxMatrixSize))
+	}
+
+	method := inequations.SimplexMethod
+	switch {
+	case req.Integer:
+		method = inequations.IntegerMethod
+	case req.Doubled:
+		method = inequations.DoubledMethod
+	case req.Bounded:
+		method = inequations.BoundedMethod
+	case req.Interior:
+		method = inequations.InteriorPointMethod
+	}
+
+	res, err := tableau.Solve(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+
+	return LPResponse{
+		Support:   res.Support,
+		Solution:  res.Optimal,
+		Objective: res.Objective,
+		Dual:      res.Dual,
+	}, nil
+}
+
+func (s *Server) handleZeroSum(ctx context.Context, r *http.Request) (any, error) {
+	var req MatrixRequest
+	if err := decode(r.Body, &req); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateMatrix(req.Matrix); err != nil {
+		return nil, err
+	}
+
+	solution, err := games.SolveZeroSum(ctx, req.Matrix.toMatrix())
+	if err != nil {
+		return nil, err
+	}
+
+	res := ZeroSumResponse{
+		GameWeight:           solution.GameWeight,
+		FirstPlayerStrategy:  solution.FirstPlayerStrategy,
+		SecondPlayerStrategy: solution.SecondPlayerStrategy,
+	}
+
+	if clean := solution.Clean; clean != nil {
+		res.Clean = &CleanSolution{Row: clean.Row, Col: clean.Col, Value: clean.Val}
+	}
+
+	return res, nil
+}
+
+func (s *Server) handleNature(_ context.Context, r *http.Request) (any, error) {
+	var req NatureRequest
+	if err := decode(r.Body, &req); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateMatrix(req.Matrix); err != nil {
+		return nil, err
+	}
+
+	p := req.P
+	if req.Normalize {
+		normalized, err := games.NormalizeProbabilities(p)
+		if err != nil {
+			return nil, badRequest(err)
+		}
+		p = normalized
+	}
+
+	m := req.Matrix.toMatrix()
+	comparison, err := games.CompareCriteria(m, games.CriteriaOptions{
+		Y:      req.Y,
+		Lambda: req.Lambda,
+		P:      p,
+	})
+	if err != nil {
+		return nil, badRequest(err)
+	}
+
+	intervals, err := games.HurwiczIntervals(m)
+	if err != nil {
+		return nil, err
+	}
+
+	res := NatureResponse{
+		Criteria:        make([]Criterion, 0, len(comparison.Criteria)),
+		HurwiczInterval: make([]Interval, 0, len(intervals)),
+	}
+
+	for _, c := range comparison.Criteria {
+		res.Criteria = append(res.Criteria, Criterion{
+			Name:     c.Name,
+			Scores:   c.Scores,
+			Best:     c.Best,
+			Minimize: c.Minimize,
+		})
+	}
+
+	for _, i := range intervals {
+		res.HurwiczInterval = append(res.HurwiczInterval, Interval{
+			From:       i.From,
+			To:         i.To,
+			Strategies: i.Strategies,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *Server) handleSimulate(ctx context.Context, r *http.Request) (any, error) {
+	var req SimulateRequest
+	if err := decode(r.Body, &req); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateMatrix(req.Matrix); err != nil {
+		return nil, err
+	}
+
+	if req.Times <= 0 || req.Times > s.cfg.MaxSimulations {
+		return nil, badRequest(fmt.Errorf("times should be in [1; %d]", s.cfg.MaxSimulations))
+	}
+
+	m := req.Matrix.toMatrix()
+	first, second := req.FirstPlayerStrategy, req.SecondPlayerStrategy
+	if first == nil && second == nil {
+		solution, err := games.SolveZeroSum(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		first, second = solution.FirstPlayerStrategy, solution.SecondPlayerStrategy
+	} else {
+		if err := games.ValidateProbabilities(first, len(m.Rows)); err != nil {
+			return nil, badRequest(fmt.Errorf("first player strategy: %w", err))
+		}
+
+		if err := games.ValidateProbabilities(second, len(m.Rows[0])); err != nil {
+			return nil, badRequest(fmt.Errorf("second player strategy: %w", err))
+		}
+	}
+
+	summary, err := games.SummarizeGame(ctx, games.SimulationOptions{
+		Matrix:               m,
+		FirstPlayerStrategy:  first,
+		SecondPlayerStrategy: second,
+		Times:                req.Times,
+		Seed:                 req.Seed,
+		Workers:              runtime.NumCPU(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return SimulateResponse{
+		Times:                summary.Times,
+		Mean:                 summary.Mean,
+		Variance:             summary.Variance,
+		StdErr:               summary.StdErr,
+		ConfidenceLow:        summary.ConfidenceLow,
+		ConfidenceHigh:       summary.ConfidenceHigh,
+		FirstPlayerStrategy:  first,
+		SecondPlayerStrategy: second,
+	}, nil
+}
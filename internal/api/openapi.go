@@ -0,0 +1,11 @@
+package api
+
+import _ "embed"
+
+//go:embed openapi.json
+var openAPI []byte
+
+// OpenAPI returns the OpenAPI 3 document describing the server.
+func OpenAPI() []byte {
+	return openAPI
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const (
+	DefaultAddr              = ":8080"
+	DefaultMaxBodyBytes      = 1 << 20
+	DefaultMaxMatrixSize     = 100
+	DefaultMaxSimulations    = 10_000_000
+	DefaultMaxConcurrent     = 16
+	DefaultRequestTimeout    = 30 * time.Second
+	DefaultReadHeaderTimeout = 5 * time.Second
+)
+
+type Config struct {
+	Addr string
+	// MaxBodyBytes limits the size of a request body.
+	MaxBodyBytes int64
+	// MaxMatrixSize limits both rows and columns of every input matrix.
+	MaxMatrixSize int
+	// MaxSimulations limits the amount of rounds of a game simulation.
+	MaxSimulations int
+	// MaxConcurrent limits the amount of requests solved at the same time.
+	MaxConcurrent int
+	// RequestTimeout cancels a solver which takes longer than that.
+	RequestTimeout time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Addr:           DefaultAddr,
+		MaxBodyBytes:   DefaultMaxBodyBytes,
+		MaxMatrixSize:  DefaultMaxMatrixSize,
+		MaxSimulations: DefaultMaxSimulations,
+		MaxConcurrent:  DefaultMaxConcurrent,
+		RequestTimeout: DefaultRequestTimeout,
+	}
+}
+
+type Server struct {
+	cfg    Config
+	slots  chan struct{}
+	http   *http.Server
+	cancel context.CancelFunc
+}
+
+func NewServer(cfg Config) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		cfg:    cfg,
+		slots:  make(chan struct{}, max(cfg.MaxConcurrent, 1)),
+		cancel: cancel,
+	}
+
+	s.http = &http.Server{
+		Addr:              cfg.Addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: DefaultReadHeaderTimeout,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	return s
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/openapi.json", s.handleOpenAPI)
+	mux.Handle("POST /v1/matrix/inverse", s.solver(s.handleInverse))
+	mux.Handle("POST /v1/lp/solve", s.solver(s.handleSolveLP))
+	mux.Handle("POST /v1/games/zero-sum", s.solver(s.handleZeroSum))
+	mux.Handle("POST /v1/games/nature", s.solver(s.handleNature))
+	mux.Handle("POST /v1/games/simulate", s.solver(s.handleSimulate))
+	return mux
+}
+
+func (s *Server) ListenAndServe() error {
+	return s.http.ListenAndServe()
+}
+
+// Shutdown stops accepting requests and waits for the running ones.
+// When ctx expires first, running solvers are cancelled.
+func (s *Server) Shutdown(ctx context.Context) error {
+	err := s.http.Shutdown(ctx)
+	s.cancel()
+	return err
+}
+
+type handlerFunc = func(ctx context.Context, r *http.Request) (any, error)
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// solver applies request limits to h and writes its result as JSON.
+func (s *Server) solver(h handlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case s.slots <- struct{}{}:
+			defer func() { <-s.slots }()
+		default:
+			writeError(w, http.StatusServiceUnavailable, errors.New("too many requests in progress"))
+			return
+		}
+
+		defer func() {
+			if rec := recover(); rec != nil {
+				writeError(w, http.StatusInternalServerError, fmt.Errorf("solver failed: %v", rec))
+			}
+		}()
+
+		// the steps of concurrent requests would interleave in the stdout
+		ctx, cancel := context.WithTimeout(matrix.Quiet(r.Context()), s.cfg.RequestTimeout)
+		defer cancel()
+
+		r.Body = http.MaxBytesReader(w, r.Body, s.cfg.MaxBodyBytes)
+		res, err := h(ctx, r)
+		if err != nil {
+			writeError(w, statusOf(err), err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, res)
+	})
+}
+
+func (s *Server) handleOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(OpenAPI())
+}
+
+func statusOf(err error) int {
+	var maxBytes *http.MaxBytesError
+	var bad *badRequestError
+	switch {
+	case errors.As(err, &maxBytes):
+		return http.StatusRequestEntityTooLarge
+	case errors.As(err, &bad):
+		return http.StatusBadRequest
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusUnprocessableEntity
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
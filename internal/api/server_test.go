@@ -0,0 +1,242 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestServer(t *testing.T) {
+	tc := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		status   int
+		expected string
+	}{
+		{
+			name:     "Should invert matrix",
+			method:   http.MethodPost,
+			path:     "/v1/matrix/inverse",
+			body:     `{"matrix":{"rows":[[1,2],[3,4]]}}`,
+			status:   http.StatusOK,
+			expected: `{"matrix":{"rows":[[-2,1],[1.5,-0.5]]}}`,
+		},
+		{
+			name:   "Should reject non square matrix",
+			method: http.MethodPost,
+			path:   "/v1/matrix/inverse",
+			body:   `{"matrix":{"rows":[[1,2,3],[3,4,5]]}}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "Should reject ragged matrix",
+			method: http.MethodPost,
+			path:   "/v1/games/zero-sum",
+			body:   `{"matrix":{"rows":[[1,2],[3]]}}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "Should reject unknown fields",
+			method: http.MethodPost,
+			path:   "/v1/matrix/inverse",
+			body:   `{"matrix":{"rows":[[1]]},"extra":1}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "Should reject too large body",
+			method: http.MethodPost,
+			path:   "/v1/matrix/inverse",
+			body:   `{"matrix":{"rows":[[` + strings.Repeat("1,", 1024) + `1]]}}`,
+			status: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:   "Should reject too large matrix",
+			method: http.MethodPost,
+			path:   "/v1/games/zero-sum",
+			body:   `{"matrix":{"rows":[[1,2,3,4,5]]}}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:     "Should solve max lp",
+			method:   http.MethodPost,
+			path:     "/v1/lp/solve",
+			body:     `{"objective":"3x1+2x2","constraints":["x1+x2<=4","x1-x2<=2"],"goal":"max"}`,
+			status:   http.StatusOK,
+			expected: `{"support":[0,0],"solution":[3,1],"objective":11}`,
+		},
+		{
+			name:     "Should solve min lp",
+			method:   http.MethodPost,
+			path:     "/v1/lp/solve",
+			body:     `{"objective":"3x1+2x2","constraints":["x1+x2>=4","x1-x2<=2"],"goal":"min"}`,
+			status:   http.StatusOK,
+			expected: `{"support":[3,1],"solution":[0,4],"objective":8}`,
+		},
+		{
+			name:     "Should solve bounded lp",
+			method:   http.MethodPost,
+			path:     "/v1/lp/solve",
+			body:     `{"objective":"3x1+2x2","constraints":["x1+x2<=4","x1-x2<=2"],"bounds":["x1 <= 1.5"],"goal":"max","bounded":true}`,
+			status:   http.StatusOK,
+			expected: `{"support":[0,0],"solution":[1.5,2.5],"objective":9.5}`,
+		},
+		{
+			name:   "Should solve lp with interior point method",
+			method: http.MethodPost,
+			path:   "/v1/lp/solve",
+			body:   `{"objective":"x1+x2","constraints":["x1+2x2<=1","2x1+x2<=1"],"goal":"max","interior":true}`,
+			status: http.StatusOK,
+		},
+		{
+			name:   "Should reject invalid bound",
+			method: http.MethodPost,
+			path:   "/v1/lp/solve",
+			body:   `{"objective":"3x1+2x2","constraints":["x1+x2<=4"],"bounds":["x3 <= 1"],"goal":"max"}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "Should reject invalid goal",
+			method: http.MethodPost,
+			path:   "/v1/lp/solve",
+			body:   `{"objective":"3x1+2x2","constraints":["x1+x2<=4"],"goal":"avg"}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:     "Should solve zero-sum game",
+			method:   http.MethodPost,
+			path:     "/v1/games/zero-sum",
+			body:     `{"matrix":{"rows":[[1,-1],[-1,1]]}}`,
+			status:   http.StatusOK,
+			expected: `{"game_weight":0,"first_player_strategy":[0.5,0.5],"second_player_strategy":[0.5,0.5]}`,
+		},
+		{
+			name:   "Should reject invalid probabilities",
+			method: http.MethodPost,
+			path:   "/v1/games/nature",
+			body:   `{"matrix":{"rows":[[1,3],[2,2]]},"y":0.5,"lambda":0.5,"p":[1,1]}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "Should reject too many simulations",
+			method: http.MethodPost,
+			path:   "/v1/games/simulate",
+			body:   `{"matrix":{"rows":[[1,-1],[-1,1]]},"times":1000000}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "Should reject invalid strategy",
+			method: http.MethodPost,
+			path:   "/v1/games/simulate",
+			body:   `{"matrix":{"rows":[[1,-1],[-1,1]]},"times":10,"first_player_strategy":[1],"second_player_strategy":[0.5,0.5]}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "Should reject wrong method",
+			method: http.MethodGet,
+			path:   "/v1/lp/solve",
+			status: http.StatusMethodNotAllowed,
+		},
+	}
+
+	cfg := DefaultConfig()
+	cfg.MaxBodyBytes = 1024
+	cfg.MaxMatrixSize = 4
+	cfg.MaxSimulations = 1000
+	h := NewServer(cfg).Handler()
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("Expected to get status: %d, got: %d %s", tt.status, rec.Code, rec.Body)
+			}
+
+			if tt.expected == "" {
+				return
+			}
+
+			var got, expected any
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal([]byte(tt.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, expected) {
+				t.Fatalf("Expected to get: %s, got: %s", tt.expected, rec.Body)
+			}
+		})
+	}
+}
+
+func TestSimulateIsReproducible(t *testing.T) {
+	h := NewServer(DefaultConfig()).Handler()
+	body := `{"matrix":{"rows":[[1,-1],[-1,1]]},"times":500,"seed":42}`
+
+	var results []string
+	for range 2 {
+		req := httptest.NewRequest(http.MethodPost, "/v1/games/simulate", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected to get status: %d, got: %d %s", http.StatusOK, rec.Code, rec.Body)
+		}
+		results = append(results, rec.Body.String())
+	}
+
+	if results[0] != results[1] {
+		t.Fatalf("Expected simulations with the same seed to be equal: %s != %s", results[0], results[1])
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	h := NewServer(DefaultConfig()).Handler()
+	req := httptest.NewRequest(http.MethodGet, "/v1/openapi.json", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var doc struct {
+		Paths      map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]any `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{
+		"/v1/matrix/inverse",
+		"/v1/lp/solve",
+		"/v1/games/zero-sum",
+		"/v1/games/nature",
+		"/v1/games/simulate",
+	} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Fatalf("Expected OpenAPI document to describe: %s", path)
+		}
+	}
+
+	// the requests are decoded with the unknown fields disallowed
+	properties := doc.Components.Schemas["LPRequest"].Properties
+	request := reflect.TypeFor[LPRequest]()
+	for i := range request.NumField() {
+		name, _, _ := strings.Cut(request.Field(i).Tag.Get("json"), ",")
+		if _, ok := properties[name]; !ok {
+			t.Fatalf("Expected OpenAPI document to describe field: %s", name)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// Matrix mirrors matrix.Matrix, only the rows are part of the API.
+type Matrix struct {
+	Rows []matrix.Row `json:"rows"`
+}
+
+func (m Matrix) toMatrix() matrix.Matrix {
+	rows := make([]matrix.Row, len(m.Rows))
+	for i, row := range m.Rows {
+		rows[i] = append(matrix.Row(nil), row...)
+	}
+
+	return matrix.Matrix{Rows: rows}
+}
+
+func fromMatrix(m matrix.Matrix) Matrix {
+	return Matrix{Rows: m.Rows}
+}
+
+type MatrixRequest struct {
+	Matrix Matrix `json:"matrix"`
+}
+
+type MatrixResponse struct {
+	Matrix Matrix `json:"matrix"`
+}
+
+type LPRequest struct {
+	Objective   string   `json:"objective"`
+	Constraints []string `json:"constraints"`
+	Goal        string   `json:"goal"`
+	Bounds      []string `json:"bounds,omitempty"`
+	Integer     bool     `json:"integer,omitempty"`
+	Doubled     bool     `json:"doubled,omitempty"`
+	Bounded     bool     `json:"bounded,omitempty"`
+	Interior    bool     `json:"interior,omitempty"`
+}
+
+type LPResponse struct {
+	Support   []float64 `json:"support"`
+	Solution  []float64 `json:"solution"`
+	Objective float64   `json:"objective"`
+	// Dual is only filled for doubled problems.
+	Dual []float64 `json:"dual,omitempty"`
+}
+
+type CleanSolution struct {
+	Row   int     `json:"row"`
+	Col   int     `json:"col"`
+	Value float64 `json:"value"`
+}
+
+type ZeroSumResponse struct {
+	Clean                *CleanSolution `json:"clean,omitempty"`
+	GameWeight           float64        `json:"game_weight"`
+	FirstPlayerStrategy  []float64      `json:"first_player_strategy"`
+	SecondPlayerStrategy []float64      `json:"second_player_strategy"`
+}
+
+type NatureRequest struct {
+	Matrix Matrix    `json:"matrix"`
+	Y      float64   `json:"y"`
+	Lambda float64   `json:"lambda"`
+	P      []float64 `json:"p"`
+	// Normalize scales p to sum up to 1 instead of rejecting it.
+	Normalize bool `json:"normalize,omitempty"`
+}
+
+type Criterion struct {
+	Name     string    `json:"name"`
+	Scores   []float64 `json:"scores"`
+	Best     []int     `json:"best"`
+	Minimize bool      `json:"minimize"`
+}
+
+type Interval struct {
+	From       float64 `json:"from"`
+	To         float64 `json:"to"`
+	Strategies []int   `json:"strategies"`
+}
+
+type NatureResponse struct {
+	Criteria        []Criterion `json:"criteria"`
+	HurwiczInterval []Interval  `json:"hurwicz_intervals"`
+}
+
+type SimulateRequest struct {
+	Matrix Matrix `json:"matrix"`
+	// Strategies are solved from the matrix when both are omitted.
+	FirstPlayerStrategy  []float64 `json:"first_player_strategy,omitempty"`
+	SecondPlayerStrategy []float64 `json:"second_player_strategy,omitempty"`
+	Times                int       `json:"times"`
+	// Seed is the current time when it's omitted.
+	Seed *int64 `json:"seed,omitempty"`
+}
+
+type SimulateResponse struct {
+	Times                int       `json:"times"`
+	Mean                 float64   `json:"mean"`
+	Variance             float64   `json:"variance"`
+	StdErr               float64   `json:"std_err"`
+	ConfidenceLow        float64   `json:"confidence_low"`
+	ConfidenceHigh       float64   `json:"confidence_high"`
+	FirstPlayerStrategy  []float64 `json:"first_player_strategy"`
+	SecondPlayerStrategy []float64 `json:"second_player_strategy"`
+}
+
+type badRequestError struct {
+	err error
+}
+
+func (e *badRequestError) Error() string {
+	return e.err.Error()
+}
+
+func (e *badRequestError) Unwrap() error {
+	return e.err
+}
+
+func badRequest(err error) error {
+	return &badRequestError{err: err}
+}
+
+func decode(r io.Reader, v any) error {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		var maxBytes *http.MaxBytesError
+		if errors.As(err, &maxBytes) {
+			return err
+		}
+
+		return badRequest(fmt.Errorf("invalid json body: %w", err))
+	}
+
+	return nil
+}
+
+func (s *Server) validateMatrix(m Matrix) error {
+	if len(m.Rows) == 0 || len(m.Rows[0]) == 0 {
+		return badRequest(errors.New("matrix should not be empty"))
+	}
+
+	if len(m.Rows) > s.cfg.MaxMatrixSize || len(m.Rows[0]) > s.cfg.MaxMatrixSize {
+		return badRequest(fmt.Errorf("matrix should not be larger than %[1]dx%[1]d", s.cfg.MaxMatrixSize))
+	}
+
+	for i, row := range m.Rows {
+		if len(row) != len(m.Rows[0]) {
+			return badRequest(fmt.Errorf("row %d should have %d columns", i, len(m.Rows[0])))
+		}
+
+		for _, el := range row {
+			if math.IsInf(el, 0) || math.IsNaN(el) {
+				return badRequest(fmt.Errorf("row %d should contain only finite numbers", i))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package assignment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+type Goal uint8
+
+const (
+	Minimize Goal = iota
+	Maximize
+)
+
+// Unassigned marks the workers left without a task.
+const Unassigned = -1
+
+var (
+	ErrEmptyMatrix = errors.New("cost matrix should have at least one row and one column")
+	ErrInvalidCost = errors.New("cost should be a finite number")
+	ErrUnknownGoal = errors.New("goal should be either min or max")
+)
+
+// Solution assigns the worker (row) i to the task (column) Assignment[i].
+// When there are more workers than tasks the extra ones are Unassigned.
+type Solution struct {
+	Assignment []int
+	Cost       float64
+}
+
+// Solve finds the assignment with the min or max total cost using the
+// Hungarian algorithm with potentials in O(n^3). Rectangular matrices
+// are padded with zero rows or columns.
+func Solve(ctx context.Context, cost matrix.Matrix, goal Goal) (*Solution, error) {
+	if err := validate(cost); err != nil {
+		return nil, err
+	}
+
+	sign := 1.
+	switch goal {
+	case Minimize:
+	case Maximize:
+		sign = -1
+	default:
+		return nil, ErrUnknownGoal
+	}
+
+	rows, cols := len(cost.Rows), len(cost.Rows[0])
+	n := max(rows, cols)
+	a := func(i, j int) float64 {
+		if i >= rows || j >= cols {
+			return 0
+		}
+
+		return sign * cost.Rows[i][j]
+	}
+
+	// u and v are potentials of rows and columns, p[j] is the row matched
+	// with the column j, all of them are 1-based with 0 being the fake
+	// column the augmenting path starts from.
+	u, v := make([]float64, n+1), make([]float64, n+1)
+	p, way := make([]int, n+1), make([]int, n+1)
+	for i := 1; i <= n; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, n+1)
+		used := make([]bool, n+1)
+		for j := range minv {
+			minv[j] = math.Inf(1)
+		}
+
+		for p[j0] != 0 {
+			used[j0] = true
+			i0, delta, j1 := p[j0], math.Inf(1), 0
+			for j := 1; j <= n; j++ {
+				if used[j] {
+					continue
+				}
+
+				if cur := a(i0-1, j-1) - u[i0] - v[j]; cur < minv[j] {
+					minv[j], way[j] = cur, j0
+				}
+
+				if minv[j] < delta {
+					delta, j1 = minv[j], j
+				}
+			}
+
+			for j := 0; j <= n; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+		}
+
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	sol := &Solution{Assignment: make([]int, rows)}
+	for i := range sol.Assignment {
+		sol.Assignment[i] = Unassigned
+	}
+
+	for j := 1; j <= n; j++ {
+		i := p[j] - 1
+		if i < rows && j-1 < cols {
+			sol.Assignment[i] = j - 1
+			sol.Cost += cost.Rows[i][j-1]
+		}
+	}
+
+	return sol, nil
+}
+
+func validate(cost matrix.Matrix) error {
+	if len(cost.Rows) == 0 || len(cost.Rows[0]) == 0 {
+		return ErrEmptyMatrix
+	}
+
+	for i, row := range cost.Rows {
+		if len(row) != len(cost.Rows[0]) {
+			return fmt.Errorf("row %d should have %d columns, got %d", i+1, len(cost.Rows[0]), len(row))
+		}
+
+		for _, c := range row {
+			if math.IsNaN(c) || math.IsInf(c, 0) {
+				return ErrInvalidCost
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package assignment
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestSolve(t *testing.T) {
+	tc := []struct {
+		name       string
+		cost       matrix.Matrix
+		goal       Goal
+		assignment []int
+		total      float64
+		err        error
+		hasErr     bool
+	}{
+		{
+			name:       "Should minimize square matrix",
+			cost:       matrix.Matrix{Rows: []matrix.Row{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}}},
+			goal:       Minimize,
+			assignment: []int{1, 0, 2},
+			total:      5,
+		},
+		{
+			name:       "Should maximize square matrix",
+			cost:       matrix.Matrix{Rows: []matrix.Row{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}}},
+			goal:       Maximize,
+			assignment: []int{0, 2, 1},
+			total:      11,
+		},
+		{
+			name:       "Should pad matrix with more tasks",
+			cost:       matrix.Matrix{Rows: []matrix.Row{{1, 2, 3}, {3, 1, 2}}},
+			goal:       Minimize,
+			assignment: []int{0, 1},
+			total:      2,
+		},
+		{
+			name:       "Should pad matrix with more workers",
+			cost:       matrix.Matrix{Rows: []matrix.Row{{5, 2}, {2, 5}, {4, 4}}},
+			goal:       Minimize,
+			assignment: []int{1, 0, Unassigned},
+			total:      4,
+		},
+		{
+			name:       "Should handle negative costs",
+			cost:       matrix.Matrix{Rows: []matrix.Row{{-1, -5}, {-3, -2}}},
+			goal:       Minimize,
+			assignment: []int{1, 0},
+			total:      -8,
+		},
+		{
+			name: "Should reject empty matrix",
+			goal: Minimize,
+			err:  ErrEmptyMatrix,
+		},
+		{
+			name: "Should reject infinite cost",
+			cost: matrix.Matrix{Rows: []matrix.Row{{math.Inf(1)}}},
+			goal: Minimize,
+			err:  ErrInvalidCost,
+		},
+		{
+			name:   "Should reject ragged matrix",
+			cost:   matrix.Matrix{Rows: []matrix.Row{{1, 2}, {3}}},
+			goal:   Minimize,
+			hasErr: true,
+		},
+		{
+			name: "Should reject unknown goal",
+			cost: matrix.Matrix{Rows: []matrix.Row{{1}}},
+			goal: Goal(42),
+			err:  ErrUnknownGoal,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Solve(context.Background(), tt.cost, tt.goal)
+			if tt.err != nil || tt.hasErr {
+				if err == nil || (tt.err != nil && !errors.Is(err, tt.err)) {
+					t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(got.Assignment, tt.assignment) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.assignment, got.Assignment)
+			}
+
+			if got.Cost != tt.total {
+				t.Fatalf("Expected to get: %v, got: %v", tt.total, got.Cost)
+			}
+		})
+	}
+}
+
+func TestSolveMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 50 {
+		rows, cols := 1+r.Intn(5), 1+r.Intn(5)
+		cost := matrix.Matrix{Rows: make([]matrix.Row, rows)}
+		for i := range cost.Rows {
+			cost.Rows[i] = make(matrix.Row, cols)
+			for j := range cost.Rows[i] {
+				cost.Rows[i][j] = float64(r.Intn(21) - 10)
+			}
+		}
+
+		for _, goal := range []Goal{Minimize, Maximize} {
+			got, err := Solve(context.Background(), cost, goal)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if expected := bruteForce(cost, goal); got.Cost != expected {
+				t.Fatalf("Expected to get: %v, got: %v for %v", expected, got.Cost, cost.Rows)
+			}
+		}
+	}
+}
+
+// bruteForce tries every way to match min(rows, cols) pairs.
+func bruteForce(cost matrix.Matrix, goal Goal) float64 {
+	rows, cols := len(cost.Rows), len(cost.Rows[0])
+	used := make([]bool, cols)
+	best, found := 0., false
+
+	var walk func(i, left int, total float64)
+	walk = func(i, left int, total float64) {
+		if left == 0 {
+			if !found || (goal == Minimize && total < best) || (goal == Maximize && total > best) {
+				best, found = total, true
+			}
+			return
+		}
+
+		if rows-i < left {
+			return
+		}
+
+		walk(i+1, left, total)
+		for j := range cols {
+			if !used[j] {
+				used[j] = true
+				walk(i+1, left-1, total+cost.Rows[i][j])
+				used[j] = false
+			}
+		}
+	}
+
+	walk(0, min(rows, cols), 0)
+	return best
+}
+
+func TestSolveCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cost := matrix.Matrix{Rows: []matrix.Row{{1, 2}, {3, 4}}}
+	if _, err := Solve(ctx, cost, Minimize); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get error: %v, got: %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hrvadl/algo/internal/assignment"
+)
+
+func HandleSolveAssignment(ctx context.Context, args []string) {
+	goal := assignment.Minimize
+	if len(args) > 0 {
+		switch args[0] {
+		case MinGoal:
+			args = args[1:]
+		case MaxGoal:
+			goal, args = assignment.Maximize, args[1:]
+		}
+	}
+
+	fmt.Printf("\nType the cost of every worker (row) doing every task (column):\n")
+	cost, err := ResolveMatrix(args)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nJust confirmation. Your matrix: \n\n")
+	cost.Print()
+
+	solution, err := assignment.Solve(ctx, cost, goal)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Println()
+	for worker, task := range solution.Assignment {
+		if task == assignment.Unassigned {
+			fmt.Printf("Worker %d: no task\n", worker+1)
+			continue
+		}
+
+		fmt.Printf("Worker %d: task %d (cost %v)\n", worker+1, task+1, cost.Rows[worker][task])
+	}
+
+	fmt.Printf("\nYour total cost: \n%v\n", solution.Cost)
+}
@@ -1,19 +1,20 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"math"
 	"os"
 	"os/signal"
+	"runtime"
 	"syscall"
 	"time"
 
 	"github.com/hrvadl/algo/internal/equations"
 	"github.com/hrvadl/algo/internal/games"
 	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/lp"
 	"github.com/hrvadl/algo/internal/matrix"
-	"github.com/hrvadl/algo/pkg/tm"
 )
 
 const GracefulShutdownTime = 1 * time.Second
@@ -22,54 +23,54 @@ const (
 	CalculateInequationFlag = 1 << iota
 	CalculateIntegerFlag
 	CalculateDoubledFlag
+	CalculateBoundedFlag
+	CalculateInteriorFlag
 )
 
 func Start() {
+	if err := console.LoadHistory(DefaultHistoryPath()); err != nil {
+		PrintError(err)
+	}
+	console.SetCompleter(CompleteCommand(DefaultRegistry))
+
 	for {
 		PrintHelp()
-		option, err := ChooseOption()
+		line, err := console.ReadCommand()
+		if isExitInput(err) {
+			PrintExitMessage()
+			os.Exit(0)
+		}
+
 		if err != nil {
 			PrintError(err)
 			continue
 		}
 
-		switch option {
-		case InverseMatrixOption:
-			HandleInverseMatrix()
-		case GetRankOption:
-			HandleGetRank()
-		case HelpOption:
-			PrintHelp()
-		case ClearOption:
-			tm.Clear()
-		case SolveLinearEquationOption:
-			HandleSolveLinearEquation()
-		case SolveLinearInequationOption:
-			HandleSolveLinearInequation(CalculateInequationFlag)
-		case SolveIntegerLinearInequationOption:
-			HandleSolveLinearInequation(CalculateInequationFlag | CalculateIntegerFlag)
-		case SolveDoubledLinearInequationOption:
-			HandleSolveLinearInequation(CalculateInequationFlag | CalculateDoubledFlag)
-		case GetGameStrategies:
-			HandleSolveGame()
-		case SolveGameWithNature:
-			HandleGameWithNature()
-		case ExitOption:
-			PrintExitMessage()
-			os.Exit(0)
+		if err := runner.Run(context.Background(), func(ctx context.Context) error {
+			return dispatchRecorded(ctx, DefaultRegistry, line)
+		}); err != nil {
+			PrintError(err)
 		}
 	}
 }
 
-func HandleInverseMatrix() {
-	fmt.Println("\nType the size of your matrix: ")
-	size, err := ReadInt()
-	if err != nil {
-		PrintError(err)
+func HandleHelp(_ context.Context, args []string) {
+	if len(args) == 0 {
+		PrintHelp()
 		return
 	}
 
-	m, err := GetMatrix(size, size)
+	c, ok := DefaultRegistry.Lookup(args[0])
+	if !ok {
+		PrintError(fmt.Errorf("unknown command %s", args[0]))
+		return
+	}
+
+	PrintCommandHelp(c)
+}
+
+func HandleInverseMatrix(ctx context.Context, args []string) {
+	m, err := ResolveSquareMatrix(args)
 	if err != nil {
 		PrintError(err)
 		return
@@ -78,7 +79,7 @@ func HandleInverseMatrix() {
 	fmt.Println("\nJust confirmation. Your matrix: ")
 	m.Print()
 
-	m, err = m.Invert()
+	m, err = m.Invert(ctx)
 	if err != nil {
 		PrintError(err)
 		return
@@ -89,8 +90,8 @@ func HandleInverseMatrix() {
 	m.Print()
 }
 
-func HandleGetRank() {
-	m, err := HandleGetMatrix()
+func HandleGetRank(ctx context.Context, args []string) {
+	m, err := ResolveMatrix(args)
 	if err != nil {
 		PrintError(err)
 		return
@@ -99,13 +100,18 @@ func HandleGetRank() {
 	fmt.Println("\nJust confirmation. Your matrix: ")
 	m.Print()
 
-	rank := m.Rank()
+	rank, err := m.Rank(ctx)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
 	fmt.Printf("\nThe rank of your matrix is: %v\n", rank)
 }
 
-func HandleSolveLinearEquation() {
+func HandleSolveLinearEquation(ctx context.Context, args []string) {
 	fmt.Println("\nInput your A matrix: ")
-	a, err := HandleGetMatrix()
+	a, err := ResolveMatrix(args[:min(len(args), 1)])
 	if err != nil {
 		PrintError(err)
 		return
@@ -113,7 +119,7 @@ func HandleSolveLinearEquation() {
 
 	fmt.Println("\nInput your B matrix: ")
 	_, rows := a.GetDimensions()
-	b, err := GetMatrix(rows, 1)
+	b, err := ResolveMatrixWithSize(args[min(len(args), 1):], rows, 1)
 	if err != nil {
 		PrintError(err)
 		return
@@ -121,28 +127,36 @@ func HandleSolveLinearEquation() {
 
 	fmt.Println()
 
-	res := equations.SolveSystem(equations.EquationSystem{
+	res, err := equations.SolveSystem(ctx, equations.EquationSystem{
 		A: a,
 		B: b,
 	})
+	if err != nil {
+		PrintError(err)
+		return
+	}
 
 	fmt.Println("\nThe result of solving equation: ")
 	fmt.Printf("%v\n", res)
 }
 
-func HandleSolveGame() {
-	m, err := HandleGetMatrix()
+func HandleSolveGame(ctx context.Context, args []string) {
+	m, err := ResolveMatrix(args)
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
-	startingPoint := m
 	fmt.Printf("\nJust confirmation. Your matrix: \n\n")
 	m.Print()
 
-	clean, err := m.GetCleanStrategySolution()
-	if err == nil {
+	solution, err := games.SolveZeroSum(ctx, m)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if clean := solution.Clean; clean != nil {
 		fmt.Printf(
 			"\n\nFound clean solution: (%d,%d) with game weight: %v\n\n",
 			clean.Row,
@@ -152,37 +166,11 @@ func HandleSolveGame() {
 		return
 	}
 
-	PrintError(err)
-
-	minabs := math.Abs(m.Min())
-	m = *m.Add(minabs)
-	compat, err := games.CompleteMatrixToCompatible(m)
-	if err != nil {
-		PrintError(err)
-		return
-	}
-
-	m.FillLeftTitle()
-	m.FillTopTitle()
-	m = *compat
-	m.InitialCols = len(m.Rows[0])
-	m.InitialRows = len(m.Rows) - 1
 	fmt.Printf("\nJust confirmation. Your matrix after correcting: \n\n")
-	m.Print()
+	solution.Corrected.Print()
 
-	support, err := inequations.FindSupportSolution(m)
-	if err != nil {
-		PrintError(err)
-		return
-	}
-
-	optimal, err := inequations.FindMaxDoubledWithOptimalSolution(support.Matrix)
-	if err != nil {
-		PrintError(err)
-		return
-	}
-
-	fmt.Printf("\nYour support solution: \n%v\n", matrix.RoundRowTo(support.Result, 2))
+	optimal := solution.Optimal
+	fmt.Printf("\nYour support solution: \n%v\n", matrix.RoundRowTo(solution.Support.Result, 2))
 	fmt.Printf("\nYour optimal solution: \n%v\n", matrix.RoundRowTo(optimal.MaxSolution.Result, 2))
 	fmt.Printf(
 		"\nYour doubled optimal solution: \n%v\n",
@@ -191,10 +179,9 @@ func HandleSolveGame() {
 	fmt.Printf("\nYour max: \n%v\n", matrix.RoundTo(optimal.Max, 2))
 	fmt.Printf("\nYour min (doubled): \n%v\n", matrix.RoundTo(optimal.Min, 2))
 
-	gameWeight := games.GetGameWeight(optimal.MaxSolution.Matrix)
-	correctedGameWeight := games.CorrectGameWeight(gameWeight, minabs)
-	firstPlayerStrategy := games.CorrectMixedStrategy(optimal.MaxSolution.Result, gameWeight)
-	secondPlayerStrategy := games.CorrectMixedStrategy(optimal.MinSolution.Result, gameWeight)
+	correctedGameWeight := solution.GameWeight
+	firstPlayerStrategy := solution.FirstPlayerStrategy
+	secondPlayerStrategy := solution.SecondPlayerStrategy
 
 	fmt.Printf("\n\nFirst player strategy: %v", firstPlayerStrategy)
 	fmt.Printf("\nSecond player strategy: %v", secondPlayerStrategy)
@@ -207,46 +194,77 @@ func HandleSolveGame() {
 		return
 	}
 
-	steps := games.SimulateGame(games.SimulationOptions{
+	opt := games.SimulationOptions{
 		Times:                n,
 		FirstPlayerStrategy:  firstPlayerStrategy,
 		SecondPlayerStrategy: secondPlayerStrategy,
-		Matrix:               startingPoint,
-	})
+		Matrix:               m,
+		Workers:              runtime.NumCPU(),
+	}
 
-	for i, s := range steps {
-		fmt.Printf("idx: %d %+v\n\n", i, s)
+	steps, err := games.SimulateGame(ctx, opt)
+	if err != nil {
+		PrintError(err)
+		return
 	}
+
+	report, err := games.BuildConvergenceReport(
+		steps,
+		firstPlayerStrategy,
+		secondPlayerStrategy,
+		correctedGameWeight,
+		games.DefaultConvergencePoints,
+	)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	PrintConvergenceReport(*report)
+
+	summary := games.SummarizeSteps(steps)
+	fmt.Printf(
+		"Average win: %v, variance: %v, 95%% confidence interval: [%v; %v]\n\n",
+		matrix.RoundTo(summary.Mean, 2),
+		matrix.RoundTo(summary.Variance, 2),
+		matrix.RoundTo(summary.ConfidenceLow, 2),
+		matrix.RoundTo(summary.ConfidenceHigh, 2),
+	)
 }
 
-func HandleGameWithNature() {
-	m, err := HandleGetMatrix()
+func HandleGameWithNature(ctx context.Context, args []string) {
+	m, err := ResolveMatrix(args)
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
+	if len(m.Rows) == 0 || len(m.Rows[0]) == 0 {
+		PrintError(games.ErrEmptyMatrix)
+		return
+	}
+
 	fmt.Printf("\nJust confirmation. Your matrix: \n\n")
 	m.Print()
 
 	fmt.Printf("\nType y coefficient for pessimist optimist strategy:\n")
-	y, err := ReadFloat()
+	y, err := ReadCoefficient()
 	if err != nil {
-		PrintError(err)
+		PrintError(fmt.Errorf("invalid y coefficient: %w", err))
 		return
 	}
 
-	fmt.Printf("\nType v coefficient for laplas strategy:\n")
-	v, err := ReadFloat()
+	fmt.Printf("\nType lambda coefficient for hodges-lehmann strategy:\n")
+	lambda, err := ReadCoefficient()
 	if err != nil {
-		PrintError(err)
+		PrintError(fmt.Errorf("invalid lambda coefficient: %w", err))
 		return
 	}
 
 	fmt.Printf("\nType the p vector for bayes straregy:\n")
-	p, err := GetMatrix(1, len(m.Rows[0]))
+	p, err := GetProbabilities(len(m.Rows[0]))
 	if err != nil {
-		PrintError(err)
+		PrintError(fmt.Errorf("invalid p vector: %w", err))
 		return
 	}
 
@@ -262,12 +280,23 @@ func HandleGameWithNature() {
 		"\n\nSolution with pessimist optimist (Gurwic) strategy: %v",
 		games.ToHumanReadable(games.SolveWithPessimismOptimismStrategy(m, y)),
 	)
+	intervals, err := games.HurwiczIntervals(m)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\n\nPessimist optimist (Gurwic) strategy for y ranges:")
+	for _, interval := range intervals {
+		fmt.Printf("\n  y in %v", interval)
+	}
+
 	fmt.Printf(
 		"\n\nSolution with minmax (Sevige) strategy: %v",
 		games.ToHumanReadable(games.SolveWithMinMaxStrategy(m)),
 	)
 
-	bsol, err := games.SolveWithBayesPrinicple(m, p.Rows[0])
+	bsol, err := games.SolveWithBayesPrinicple(m, p)
 	if err != nil {
 		PrintError(fmt.Errorf("cannot solve with bayes principle: %w", err))
 		return
@@ -275,120 +304,226 @@ func HandleGameWithNature() {
 
 	fmt.Printf("\n\nSolution with bayes strategy: %v", games.ToHumanReadable(bsol))
 
-	lsol, err := games.SolveWithLaplasPrinciple(m, v)
+	lsol, err := games.SolveWithLaplasPrinciple(m)
 	if err != nil {
 		PrintError(fmt.Errorf("cannot solve with laplas principle: %w", err))
 		return
 	}
 
-	fmt.Printf("\n\nSolution with laplas strategy: %v\n\n", games.ToHumanReadable(lsol))
-}
+	fmt.Printf("\n\nSolution with laplas strategy: %v", games.ToHumanReadable(lsol))
 
-func HandleSolveLinearInequation(flag uint8) {
-	fmt.Printf("\nInput Z: \n")
-	z, err := GetNegativeFunctionRow()
+	hsol, err := games.SolveWithHodgesLehmannPrinciple(m, p, lambda)
 	if err != nil {
-		PrintError(err)
+		PrintError(fmt.Errorf("cannot solve with hodges-lehmann principle: %w", err))
 		return
 	}
 
-	fmt.Printf("\nInput amount of inequation limitations: \n")
-	n, err := ReadPositiveInt()
+	fmt.Printf("\n\nSolution with hodges-lehmann strategy: %v", games.ToHumanReadable(hsol))
+	fmt.Printf(
+		"\n\nSolution with product strategy: %v",
+		games.ToHumanReadable(games.SolveWithProductPrinciple(m)),
+	)
+
+	gsol, err := games.SolveWithGermeierPrinciple(m, p)
 	if err != nil {
-		PrintError(err)
+		PrintError(fmt.Errorf("cannot solve with germeier principle: %w", err))
 		return
 	}
 
-	m := matrix.Matrix{
-		Rows:      make([]matrix.Row, 0, n+1),
-		LeftTitle: make([]matrix.Variable, n+1),
+	fmt.Printf("\n\nSolution with germeier strategy: %v", games.ToHumanReadable(gsol))
+
+	rsol, err := games.SolveWithBayesRegretPrinciple(m, p)
+	if err != nil {
+		PrintError(fmt.Errorf("cannot solve with bayes regret principle: %w", err))
+		return
 	}
 
-	for i := range n {
-		fmt.Printf("\nInput the inequation: \n")
-		row, isEquation, err := GetNegativeRowFromExpression()
-		if err != nil {
-			PrintError(err)
-			return
-		}
+	fmt.Printf("\n\nSolution with bayes regret strategy: %v\n\n", games.ToHumanReadable(rsol))
 
-		if len(row) != len(z) {
-			PrintError(errors.New("rows should have the same size"))
-			return
-		}
+	comparison, err := games.CompareCriteria(m, games.CriteriaOptions{
+		Y:      y,
+		Lambda: lambda,
+		P:      p,
+	})
+	if err != nil {
+		PrintError(err)
+		return
+	}
 
-		if isEquation {
-			m.LeftTitle[i] = matrix.Variable{
-				FirstStageName:   "0",
-				SecondStageName:  "u",
-				SecondStageIndex: i,
-			}
-		} else {
-			m.LeftTitle[i] = matrix.Variable{
-				FirstStageName:   "y",
-				FirstStageIndex:  i,
-				SecondStageName:  "u",
-				SecondStageIndex: i,
-			}
-		}
+	PrintCriteriaComparison(*comparison)
+}
+
+func HandleSolveLinearInequation(ctx context.Context, flag uint8, args []string) {
+	model, err := ResolveModel(args)
+	if err != nil {
+		PrintError(err)
+		return
+	}
 
-		m.Rows = append(m.Rows, row)
+	problem, err := model.Problem()
+	if err != nil {
+		PrintError(err)
+		return
 	}
 
-	m.Rows = append(m.Rows, z)
-	fmt.Printf("\nJust confirmation. Your matrix: \n\n")
-	m.Print()
+	if flag&CalculateBoundedFlag != 0 {
+		HandleGetBoundedSolution(ctx, problem)
+		return
+	}
+
+	if flag&CalculateInteriorFlag != 0 {
+		HandleGetInteriorPointSolution(ctx, problem)
+		return
+	}
 
-	fmt.Printf("\nDo you want to find min or max?\n")
-	minMax, err := ReadWord()
+	tableau, err := problem.Compile()
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
-	m.FillTopTitle()
-	m.InitialCols = len(m.Rows[0])
-	m.InitialRows = len(m.Rows) - 1
+	m := tableau.Matrix
+	fmt.Printf("\nJust confirmation. Your matrix: \n\n")
+	m.Print()
 
-	switch minMax {
-	case "max":
+	// the columns of shifted and free variables aren't the variables, so
+	// the solution is decoded back instead of printed from the tableau
+	if !problem.IsNonNegative() {
+		method := inequations.SimplexMethod
+		switch {
+		case flag&CalculateIntegerFlag != 0:
+			method = inequations.IntegerMethod
+		case flag&CalculateDoubledFlag != 0:
+			method = inequations.DoubledMethod
+		}
+
+		PrintDecodedSolution(ctx, problem, tableau, method)
+		return
+	}
+
+	switch model.Goal {
+	case MaxGoal:
 		if flag&CalculateIntegerFlag != 0 {
-			HandleGetMaxWithIntegerOptimalSolution(m)
+			HandleGetMaxWithIntegerOptimalSolution(ctx, m)
 			return
 		}
 
 		if flag&CalculateDoubledFlag != 0 {
-			HandleGetDoubledMaxWithOptimalSolution(m)
+			HandleGetDoubledMaxWithOptimalSolution(ctx, m)
 			return
 		}
 
-		HandleGetMaxWithOptimalSolution(m)
+		HandleGetMaxWithOptimalSolution(ctx, m)
 		return
 
-	case "min":
+	case MinGoal:
 		if flag&CalculateIntegerFlag != 0 {
-			HandleGetMinWithIntegerOptimalSolution(m)
+			HandleGetMinWithIntegerOptimalSolution(ctx, m)
 			return
 		}
 
 		if flag&CalculateDoubledFlag != 0 {
-			HandleGetDoubledMinWithOptimalSolution(m)
+			HandleGetDoubledMinWithOptimalSolution(ctx, m)
 			return
 		}
 
-		HandleGetMinWithOptimalSolution(m)
+		HandleGetMinWithOptimalSolution(ctx, m)
 		return
 	}
 }
 
-func HandleGetMinWithIntegerOptimalSolution(m matrix.Matrix) {
+// HandleGetBoundedSolution keeps the bounds out of the tableau, so it has
+// a row per constraint and a column per variable.
+func HandleGetBoundedSolution(ctx context.Context, problem lp.Problem) {
+	tableau, err := problem.CompileBounded()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	m := tableau.Matrix
+	fmt.Printf("\nJust confirmation. Your matrix: \n\n")
+	m.Print()
+
+	fmt.Printf("\nBounds of the variables: \n")
+	for i, v := range problem.Variables {
+		b := problem.Bound(i)
+		fmt.Printf("%v <= %s <= %v\n", b.Lower, v, b.Upper)
+	}
+
+	PrintDecodedSolution(ctx, problem, tableau, inequations.BoundedMethod)
+}
+
+// PrintDecodedSolution solves the tableau and prints the solution in
+// terms of the problem variables.
+func PrintDecodedSolution(ctx context.Context, problem lp.Problem, tableau *lp.Tableau, method inequations.Method) {
+	solution, err := tableau.Solve(ctx, method)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nYour support solution: \n%v\n", solution.Support)
+	fmt.Printf("\nYour optimal solution: \n%v\n", solution.Optimal)
+	fmt.Printf("\nYour %s: \n%v\n", problem.Sense, matrix.RoundTo(solution.Objective, 2))
+	if method != inequations.DoubledMethod {
+		return
+	}
+
+	fmt.Printf("\nYour dual solution: \n%v\n", solution.Dual)
+	err = problem.CheckDuality(solution.Optimal, solution.Dual, lp.Tolerance)
+	if errors.Is(err, lp.ErrUnsupportedBound) {
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("\nDoubled solution is not confirmed: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nStrong duality and complementary slackness hold\n")
+}
+
+// HandleGetInteriorPointSolution prints the interior solution next to
+// the basic one the crossover arrives at, neither of them is rounded.
+func HandleGetInteriorPointSolution(ctx context.Context, problem lp.Problem) {
+	tableau, err := problem.Compile()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	m := tableau.Matrix
+	fmt.Printf("\nJust confirmation. Your matrix: \n\n")
+	m.Print()
+
+	solution, err := tableau.Solve(ctx, inequations.InteriorPointMethod)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nYour interior solution: \n%v\n", solution.Support)
+	fmt.Printf("\nYour optimal solution: \n%v\n", solution.Optimal)
+	fmt.Printf("\nYour dual solution: \n%v\n", solution.Dual)
+	fmt.Printf("\nYour %s: \n%v\n", problem.Sense, solution.Objective)
+
+	if err := problem.CheckDuality(solution.Optimal, solution.Dual, lp.Tolerance); err != nil {
+		fmt.Printf("\nSolution is not confirmed: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nStrong duality and complementary slackness hold\n")
+}
+
+func HandleGetMinWithIntegerOptimalSolution(ctx context.Context, m matrix.Matrix) {
 	m, err := m.DeleteZeros()
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
-	optimal, support, err := inequations.FindMinIntegerSolution(m)
+	optimal, support, err := inequations.FindMinIntegerSolution(ctx, m)
 	if err != nil {
 		PrintError(err)
 		return
@@ -399,14 +534,14 @@ func HandleGetMinWithIntegerOptimalSolution(m matrix.Matrix) {
 	fmt.Printf("\nYour min: \n%v\n", optimal.Min)
 }
 
-func HandleGetMaxWithIntegerOptimalSolution(m matrix.Matrix) {
+func HandleGetMaxWithIntegerOptimalSolution(ctx context.Context, m matrix.Matrix) {
 	m, err := m.DeleteZeros()
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
-	optimal, support, err := inequations.FindMaxIntegerSolution(m)
+	optimal, support, err := inequations.FindMaxIntegerSolution(ctx, m)
 	if err != nil {
 		PrintError(err)
 		return
@@ -417,21 +552,45 @@ func HandleGetMaxWithIntegerOptimalSolution(m matrix.Matrix) {
 	fmt.Printf("\nYour max: \n%v\n", optimal.Max)
 }
 
-func HandleGetDoubledMinWithOptimalSolution(m matrix.Matrix) {
-	m, err := m.DeleteZeros()
+// PrintDualityCheck checks the doubled answer against the dual problem
+// of the primal one.
+func PrintDualityCheck(primal lp.Problem, res inequations.Result) {
+	tableau, err := primal.Compile()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	sol := tableau.DecodeResult(res)
+	if err := primal.CheckDuality(sol.Optimal, sol.Dual, lp.Tolerance); err != nil {
+		fmt.Printf("\nDoubled solution is not confirmed: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nStrong duality and complementary slackness hold\n")
+}
+
+func HandleGetDoubledMinWithOptimalSolution(ctx context.Context, m matrix.Matrix) {
+	primal, err := lp.FromTableau(lp.Minimize, m)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	m, err = m.DeleteZeros()
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
 	fmt.Printf("\nFinding the support solution...\n")
-	support, err := inequations.FindMinWithSupportSolution(m)
+	support, err := inequations.FindMinWithSupportSolution(ctx, m)
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
-	optimal, err := inequations.FindMinDoubledWithOptimalSolution(support.Matrix)
+	optimal, err := inequations.FindMinDoubledWithOptimalSolution(ctx, support.Matrix)
 	if err != nil {
 		PrintError(err)
 		return
@@ -444,23 +603,35 @@ func HandleGetDoubledMinWithOptimalSolution(m matrix.Matrix) {
 
 	fmt.Printf("\nYour min: \n%v\n", optimal.Min)
 	fmt.Printf("\nYour max (doubled): \n%v\n", optimal.Max)
+
+	PrintDualityCheck(primal, inequations.Result{
+		Optimal:   optimal.MinSolution.Solution,
+		Objective: optimal.Min,
+		Dual:      optimal.MaxSolution.Result,
+	})
 }
 
-func HandleGetDoubledMaxWithOptimalSolution(m matrix.Matrix) {
-	m, err := m.DeleteZeros()
+func HandleGetDoubledMaxWithOptimalSolution(ctx context.Context, m matrix.Matrix) {
+	primal, err := lp.FromTableau(lp.Maximize, m)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	m, err = m.DeleteZeros()
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
 	fmt.Printf("\nFinding the support solution...\n")
-	support, err := inequations.FindSupportSolution(m)
+	support, err := inequations.FindSupportSolution(ctx, m)
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
-	optimal, err := inequations.FindMaxDoubledWithOptimalSolution(support.Matrix)
+	optimal, err := inequations.FindMaxDoubledWithOptimalSolution(ctx, support.Matrix)
 	if err != nil {
 		PrintError(err)
 		return
@@ -471,9 +642,15 @@ func HandleGetDoubledMaxWithOptimalSolution(m matrix.Matrix) {
 	fmt.Printf("\nYour doubled optimal solution: \n%v\n", optimal.MinSolution.Result)
 	fmt.Printf("\nYour max: \n%v\n", optimal.Max)
 	fmt.Printf("\nYour min (doubled): \n%v\n", optimal.Min)
+
+	PrintDualityCheck(primal, inequations.Result{
+		Optimal:   optimal.MaxSolution.Solution,
+		Objective: optimal.Max,
+		Dual:      optimal.MinSolution.Result,
+	})
 }
 
-func HandleGetMinWithOptimalSolution(m matrix.Matrix) {
+func HandleGetMinWithOptimalSolution(ctx context.Context, m matrix.Matrix) {
 	m, err := m.DeleteZeros()
 	if err != nil {
 		PrintError(err)
@@ -481,13 +658,13 @@ func HandleGetMinWithOptimalSolution(m matrix.Matrix) {
 	}
 
 	fmt.Printf("\nFinding the support solution...\n")
-	support, err := inequations.FindMinWithSupportSolution(m)
+	support, err := inequations.FindMinWithSupportSolution(ctx, m)
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
-	optimal, err := inequations.FindMinWithOptimalSolution(support.Matrix)
+	optimal, err := inequations.FindMinWithOptimalSolution(ctx, support.Matrix)
 	if err != nil {
 		PrintError(err)
 		return
@@ -498,7 +675,7 @@ func HandleGetMinWithOptimalSolution(m matrix.Matrix) {
 	fmt.Printf("\nYour min: \n%v\n", optimal.Min)
 }
 
-func HandleGetMaxWithOptimalSolution(m matrix.Matrix) {
+func HandleGetMaxWithOptimalSolution(ctx context.Context, m matrix.Matrix) {
 	m, err := m.DeleteZeros()
 	if err != nil {
 		PrintError(err)
@@ -506,13 +683,13 @@ func HandleGetMaxWithOptimalSolution(m matrix.Matrix) {
 	}
 
 	fmt.Printf("\nFinding the support solution...\n")
-	support, err := inequations.FindSupportSolution(m)
+	support, err := inequations.FindSupportSolution(ctx, m)
 	if err != nil {
 		PrintError(err)
 		return
 	}
 
-	optimal, err := inequations.FindMaxWithOptimalSolution(support.Matrix)
+	optimal, err := inequations.FindMaxWithOptimalSolution(ctx, support.Matrix)
 	if err != nil {
 		PrintError(err)
 		return
@@ -539,10 +716,23 @@ func HandleGetMatrix() (matrix.Matrix, error) {
 	return GetMatrix(rows, col)
 }
 
+// HandleGracefulShutdown aborts the running command on SIGINT and returns
+// to the menu. It returns when there is nothing to abort or on SIGTERM,
+// giving the running command up to GracefulShutdownTime to stop.
 func HandleGracefulShutdown() {
 	terminated := make(chan os.Signal, 1)
 	signal.Notify(terminated, syscall.SIGINT, syscall.SIGTERM)
-	reason := <-terminated
-	fmt.Printf("\nReceived: %v. Terminating...\n", reason.String())
-	time.Sleep(GracefulShutdownTime)
+	defer signal.Stop(terminated)
+
+	for reason := range terminated {
+		if reason == syscall.SIGINT && runner.Cancel() {
+			fmt.Printf("\nReceived: %v. Aborting current command...\n", reason.String())
+			continue
+		}
+
+		fmt.Printf("\nReceived: %v. Terminating...\n", reason.String())
+		runner.Cancel()
+		runner.Wait(GracefulShutdownTime)
+		return
+	}
 }
@@ -0,0 +1,298 @@
+package cli
+
+import (
+	"context"
+	"os"
+
+	"github.com/hrvadl/algo/pkg/command"
+	"github.com/hrvadl/algo/pkg/tm"
+)
+
+const (
+	ExitOption                          = "exit"
+	InverseMatrixOption                 = "inverse"
+	GetRankOption                       = "rank"
+	SolveLinearEquationOption           = "solve_equtaion"
+	SolveLinearInequationOption         = "solve_inequation"
+	SolveIntegerLinearInequationOption  = "solve_integer"
+	SolveDoubledLinearInequationOption  = "solve_doubled"
+	SolveBoundedLinearInequationOption  = "solve_bounded"
+	SolveInteriorLinearInequationOption = "solve_interior"
+	SolveMultiObjectiveOption           = "solve_multi"
+	ParametricOption                    = "parametric"
+	SolveQuadraticOption                = "solve_qp"
+	OptimizeOption                      = "optimize"
+	GetGameStrategies                   = "game_strategies"
+	SolveGameWithNature                 = "game_with_nature"
+	SolveTransportOption                = "transport"
+	SolveAssignmentOption               = "assignment"
+	MaxFlowOption                       = "max_flow"
+	MinCostFlowOption                   = "min_cost_flow"
+	HelpOption                          = "help"
+	ClearOption                         = "clear"
+)
+
+const (
+	LetOption            = "let"
+	LetModelOption       = "let_lp"
+	ListVariablesOption  = "vars"
+	DeleteVariableOption = "del"
+	SaveWorkspaceOption  = "save"
+	LoadWorkspaceOption  = "load"
+	TimeoutOption        = "timeout"
+	ExportLatexOption    = "latex"
+	ExportReportOption   = "report"
+)
+
+const UndoRowCommand = "undo"
+
+var (
+	matrixArg = Arg{
+		Name:     "matrix",
+		Help:     "workspace matrix to use instead of typing it",
+		Optional: true,
+	}
+	modelArg = Arg{
+		Name:     "model",
+		Help:     "workspace lp model to use instead of typing it",
+		Optional: true,
+	}
+)
+
+var DefaultRegistry = command.Default
+
+func Register(c Command) error {
+	return command.Register(c)
+}
+
+func init() {
+	registerBuiltins(DefaultRegistry)
+}
+
+func registerBuiltins(r *Registry) {
+	r.MustRegister(Command{
+		Name:    ExitOption,
+		Aliases: []string{"quit"},
+		Help:    "Exit the program",
+		Handler: func(context.Context, []string) {
+			PrintExitMessage()
+			os.Exit(0)
+		},
+	})
+	r.MustRegister(Command{
+		Name:    InverseMatrixOption,
+		Args:    []Arg{matrixArg},
+		Help:    "Calculate inverse matrix",
+		Handler: HandleInverseMatrix,
+	})
+	r.MustRegister(Command{
+		Name:    GetRankOption,
+		Args:    []Arg{matrixArg},
+		Help:    "Calculate rank of the matrix",
+		Handler: HandleGetRank,
+	})
+	r.MustRegister(Command{
+		Name:    SolveLinearEquationOption,
+		Args:    []Arg{matrixArg, {Name: "B", Help: "workspace matrix with free terms", Optional: true}},
+		Aliases: []string{"solve_equation"},
+		Help:    "Calculate linear equation system",
+		Handler: HandleSolveLinearEquation,
+	})
+	r.MustRegister(Command{
+		Name: SolveLinearInequationOption,
+		Args: []Arg{modelArg},
+		Help: "Calculate linear inequation",
+		Handler: func(ctx context.Context, args []string) {
+			HandleSolveLinearInequation(ctx, CalculateInequationFlag, args)
+		},
+	})
+	r.MustRegister(Command{
+		Name: SolveIntegerLinearInequationOption,
+		Args: []Arg{modelArg},
+		Help: "Calculate integer linear inequation",
+		Handler: func(ctx context.Context, args []string) {
+			HandleSolveLinearInequation(ctx, CalculateInequationFlag|CalculateIntegerFlag, args)
+		},
+	})
+	r.MustRegister(Command{
+		Name: SolveDoubledLinearInequationOption,
+		Args: []Arg{modelArg},
+		Help: "Calculate doubled linear inequation",
+		Handler: func(ctx context.Context, args []string) {
+			HandleSolveLinearInequation(ctx, CalculateInequationFlag|CalculateDoubledFlag, args)
+		},
+	})
+	r.MustRegister(Command{
+		Name: SolveBoundedLinearInequationOption,
+		Args: []Arg{modelArg},
+		Help: "Calculate linear inequation with bounded variables",
+		Handler: func(ctx context.Context, args []string) {
+			HandleSolveLinearInequation(ctx, CalculateInequationFlag|CalculateBoundedFlag, args)
+		},
+	})
+	r.MustRegister(Command{
+		Name: SolveInteriorLinearInequationOption,
+		Args: []Arg{modelArg},
+		Help: "Calculate linear inequation with interior point method",
+		Handler: func(ctx context.Context, args []string) {
+			HandleSolveLinearInequation(ctx, CalculateInequationFlag|CalculateInteriorFlag, args)
+		},
+	})
+	r.MustRegister(Command{
+		Name: SolveMultiObjectiveOption,
+		Args: []Arg{
+			{Name: "mode", Help: "weighted (default), lex, goal or pareto", Optional: true},
+			modelArg,
+		},
+		Help:    "Calculate linear inequation with several objectives",
+		Handler: HandleSolveMultiObjective,
+	})
+	r.MustRegister(Command{
+		Name: ParametricOption,
+		Args: []Arg{
+			{Name: "kind", Help: "rhs (default) or objective", Optional: true},
+			modelArg,
+		},
+		Help:    "Calculate linear inequation for every value of a parameter",
+		Handler: HandleParametric,
+	})
+	r.MustRegister(Command{
+		Name: SolveQuadraticOption,
+		Args: []Arg{
+			modelArg,
+			{Name: "Q", Help: "workspace matrix of the quadratic part", Optional: true},
+		},
+		Help:    "Calculate convex quadratic program",
+		Handler: HandleSolveQuadratic,
+	})
+	r.MustRegister(Command{
+		Name: OptimizeOption,
+		Args: []Arg{
+			{Name: "method", Help: "gradient, newton, penalty or lagrange", Optional: true},
+		},
+		Help:    "Optimize non-linear function",
+		Handler: HandleOptimize,
+	})
+	r.MustRegister(Command{
+		Name:    GetGameStrategies,
+		Args:    []Arg{matrixArg},
+		Help:    "Get game strategies",
+		Handler: HandleSolveGame,
+	})
+	r.MustRegister(Command{
+		Name:    SolveGameWithNature,
+		Args:    []Arg{matrixArg},
+		Help:    "Solve game with nature",
+		Handler: HandleGameWithNature,
+	})
+	r.MustRegister(Command{
+		Name: SolveTransportOption,
+		Help: "Solve transportation problem",
+		Args: []Arg{
+			{Name: "method", Help: "initial plan method: nw, min or vogel (default)", Optional: true},
+			matrixArg,
+		},
+		Handler: HandleSolveTransport,
+	})
+	r.MustRegister(Command{
+		Name: SolveAssignmentOption,
+		Help: "Solve assignment problem",
+		Args: []Arg{
+			{Name: "goal", Help: "min (default) or max", Optional: true},
+			matrixArg,
+		},
+		Handler: HandleSolveAssignment,
+	})
+	r.MustRegister(Command{
+		Name: MaxFlowOption,
+		Help: "Find max flow and min cut of the network",
+		Args: []Arg{
+			{Name: "algorithm", Help: "dinic (default) or ek for Edmonds-Karp", Optional: true},
+			matrixArg,
+		},
+		Handler: HandleMaxFlow,
+	})
+	r.MustRegister(Command{
+		Name: MinCostFlowOption,
+		Help: "Find min cost flow of the network",
+		Args: []Arg{
+			{Name: "capacity", Help: "workspace capacity matrix", Optional: true},
+			{Name: "cost", Help: "workspace cost matrix", Optional: true},
+		},
+		Handler: HandleMinCostFlow,
+	})
+	r.MustRegister(Command{
+		Name: LetOption,
+		Help: "Store matrix in the workspace",
+		Args: []Arg{
+			{Name: "name", Help: "variable name, e.g. let A = [1 2; 3 4]"},
+			{Name: "value", Help: "matrix literal, typed interactively when omitted", Optional: true, Rest: true},
+		},
+		Handler: HandleLet,
+	})
+	r.MustRegister(Command{
+		Name:    LetModelOption,
+		Help:    "Store lp model in the workspace",
+		Args:    []Arg{{Name: "name", Help: "variable name"}},
+		Handler: HandleLetModel,
+	})
+	r.MustRegister(Command{
+		Name:    ListVariablesOption,
+		Aliases: []string{"ls"},
+		Help:    "List workspace variables",
+		Handler: HandleListVariables,
+	})
+	r.MustRegister(Command{
+		Name:    DeleteVariableOption,
+		Help:    "Delete workspace variable",
+		Args:    []Arg{{Name: "name", Help: "variable name"}},
+		Handler: HandleDeleteVariable,
+	})
+	r.MustRegister(Command{
+		Name:    SaveWorkspaceOption,
+		Help:    "Save workspace to the file",
+		Args:    []Arg{{Name: "file", Help: "path to the workspace file"}},
+		Handler: HandleSaveWorkspace,
+	})
+	r.MustRegister(Command{
+		Name:    LoadWorkspaceOption,
+		Help:    "Load workspace from the file",
+		Args:    []Arg{{Name: "file", Help: "path to the workspace file"}},
+		Handler: HandleLoadWorkspace,
+	})
+	r.MustRegister(Command{
+		Name:    ExportLatexOption,
+		Help:    "Export last solver steps to LaTeX",
+		Args:    []Arg{{Name: "file", Help: "path to the .tex file"}},
+		Handler: HandleExportLatex,
+	})
+	r.MustRegister(Command{
+		Name:    ExportReportOption,
+		Help:    "Solve lp model and save the report",
+		Args:    []Arg{{Name: "file", Help: "path to the .md or .html file"}, modelArg},
+		Handler: HandleExportReport,
+	})
+	r.MustRegister(Command{
+		Name: TimeoutOption,
+		Help: "Show or set per-command timeout",
+		Args: []Arg{{
+			Name:     "duration",
+			Help:     "timeout like 30s or 2m, 0 disables it",
+			Optional: true,
+		}},
+		Handler: HandleTimeout,
+	})
+	r.MustRegister(Command{
+		Name:    HelpOption,
+		Aliases: []string{"?"},
+		Help:    "Print this message",
+		Args:    []Arg{{Name: "command", Help: "command to describe", Optional: true}},
+		Handler: HandleHelp,
+	})
+	r.MustRegister(Command{
+		Name:    ClearOption,
+		Aliases: []string{"cls"},
+		Help:    "Clear the screen",
+		Handler: func(context.Context, []string) { tm.Clear() },
+	})
+}
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"golang.org/x/term"
+
+	"github.com/hrvadl/algo/pkg/repl"
+)
+
+const (
+	HistoryFileEnv  = "ALGO_HISTORY"
+	HistoryFileName = ".algo_history"
+	CommandPrompt   = "> "
+)
+
+type Console struct {
+	in       *bufio.Reader
+	out      io.Writer
+	fd       int
+	terminal bool
+	editor   *repl.Editor
+}
+
+var console = NewConsole(os.Stdin, os.Stdout)
+
+func NewConsole(in *os.File, out io.Writer) *Console {
+	reader := bufio.NewReader(in)
+	fd := int(in.Fd())
+	return &Console{
+		in:       reader,
+		out:      out,
+		fd:       fd,
+		terminal: term.IsTerminal(fd),
+		editor:   repl.NewEditor(reader, out),
+	}
+}
+
+func (c *Console) IsTerminal() bool {
+	return c.terminal
+}
+
+func (c *Console) LoadHistory(path string) error {
+	h, err := repl.LoadHistory(path, repl.DefaultHistorySize)
+	if err != nil {
+		return err
+	}
+
+	c.editor.History = h
+	return nil
+}
+
+func (c *Console) SetCompleter(completer repl.Completer) {
+	c.editor.Completer = completer
+}
+
+func (c *Console) ReadCommand() (string, error) {
+	line, err := c.ReadLine(CommandPrompt, "")
+	if err != nil {
+		return "", err
+	}
+
+	if !c.terminal {
+		return line, nil
+	}
+
+	if err := c.editor.History.Add(line); err != nil {
+		PrintError(err)
+	}
+
+	return line, nil
+}
+
+// ReadLine falls back to plain line reading when stdin is not a terminal,
+// in that case the initial text is ignored.
+func (c *Console) ReadLine(prompt, initial string) (string, error) {
+	if !c.terminal {
+		line, err := c.in.ReadString('\n')
+		if err != nil && line == "" {
+			return "", err
+		}
+		return strings.TrimSpace(line), nil
+	}
+
+	state, err := term.MakeRaw(c.fd)
+	if err != nil {
+		return "", err
+	}
+	defer term.Restore(c.fd, state)
+
+	line, err := c.editor.ReadLine(prompt, initial)
+	return strings.TrimSpace(line), err
+}
+
+func DefaultHistoryPath() string {
+	if path := os.Getenv(HistoryFileEnv); path != "" {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, HistoryFileName)
+}
+
+func CompleteCommand(r *Registry) repl.Completer {
+	return func(prefix string) []string {
+		if idx := strings.LastIndexFunc(prefix, unicode.IsSpace); idx != -1 {
+			return completeVariable(prefix[:idx+1], prefix[idx+1:])
+		}
+
+		var res []string
+		for _, c := range r.Commands() {
+			for _, name := range append([]string{c.Name}, c.Aliases...) {
+				if strings.HasPrefix(name, prefix) {
+					res = append(res, name+" ")
+				}
+			}
+		}
+		return res
+	}
+}
+
+func isExitInput(err error) bool {
+	return errors.Is(err, repl.ErrInterrupted) || errors.Is(err, io.EOF)
+}
+
+func completeVariable(line, prefix string) []string {
+	var res []string
+	for _, name := range session.Names() {
+		if strings.HasPrefix(name, prefix) {
+			res = append(res, line+name)
+		}
+	}
+	return res
+}
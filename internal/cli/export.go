@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/hrvadl/algo/internal/latex"
+	"github.com/hrvadl/algo/internal/matrix"
+	"github.com/hrvadl/algo/internal/report"
+)
+
+var ErrNoSteps = errors.New("there are no elimination steps yet, run a solver first")
+
+// history keeps pivot steps of the last command which made any.
+var history stepHistory
+
+type stepHistory struct {
+	mu    sync.Mutex
+	steps []matrix.PivotStep
+}
+
+func (h *stepHistory) Set(steps []matrix.PivotStep) {
+	if len(steps) == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.steps = steps
+}
+
+func (h *stepHistory) Steps() []matrix.PivotStep {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.steps
+}
+
+// dispatchRecorded runs the command and remembers its pivot steps.
+func dispatchRecorded(ctx context.Context, r *Registry, line string) error {
+	ctx, recorder := matrix.Record(ctx)
+	defer func() { history.Set(recorder.Steps()) }()
+	return r.Dispatch(ctx, line)
+}
+
+func HandleExportLatex(_ context.Context, args []string) {
+	steps := history.Steps()
+	if len(steps) == 0 {
+		PrintError(ErrNoSteps)
+		return
+	}
+
+	f, err := os.Create(args[0])
+	if err != nil {
+		PrintError(err)
+		return
+	}
+	defer f.Close()
+
+	if err := latex.Write(f, latex.Document{Steps: steps}); err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nSaved %d steps to %s\n", len(steps), args[0])
+}
+
+// HandleExportReport solves the lp model and saves the report, HTML is
+// chosen by the .html extension and Markdown otherwise.
+func HandleExportReport(ctx context.Context, args []string) {
+	model, err := ResolveModel(args[1:])
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	r, err := report.Build(ctx, model)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	f, err := os.Create(args[0])
+	if err != nil {
+		PrintError(err)
+		return
+	}
+	defer f.Close()
+
+	render := r.Markdown
+	if ext := strings.ToLower(filepath.Ext(args[0])); ext == ".html" || ext == ".htm" {
+		render = r.HTML
+	}
+
+	if err := render(f); err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nSaved report with %d steps to %s\n", len(r.Steps), args[0])
+}
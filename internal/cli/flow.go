@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hrvadl/algo/internal/flow"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+var flowAlgorithms = map[string]flow.Algorithm{
+	"dinic": flow.Dinic,
+	"ek":    flow.EdmondsKarp,
+}
+
+func HandleMaxFlow(ctx context.Context, args []string) {
+	algorithm := flow.Dinic
+	if len(args) > 0 {
+		if a, ok := flowAlgorithms[args[0]]; ok {
+			algorithm, args = a, args[1:]
+		}
+	}
+
+	fmt.Printf("\nType the capacity of the arc from every node (row) to every node (column), 0 if there's no arc:\n")
+	capacity, err := ResolveSquareMatrix(args)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	source, sink, err := readFlowEnds()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	res, err := flow.MaxFlow(ctx, capacity, source, sink, algorithm)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nFlow (%v algorithm):\n\n", algorithm)
+	flows := res.Flow.Round()
+	flows.Print()
+
+	fmt.Printf("\nMin cut source side: \n%v\n", oneBased(res.Cut.Source))
+	fmt.Printf("\nMin cut arcs:\n")
+	for _, e := range res.Cut.Edges {
+		fmt.Printf("%d -> %d: %v\n", e.From+1, e.To+1, matrix.RoundTo(e.Capacity, 2))
+	}
+
+	fmt.Printf("\nYour max flow: \n%v\n", matrix.RoundTo(res.Value, 2))
+}
+
+func HandleMinCostFlow(ctx context.Context, args []string) {
+	fmt.Printf("\nType the capacity of the arc from every node (row) to every node (column), 0 if there's no arc:\n")
+	capacity, err := ResolveSquareMatrix(args)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if len(args) > 0 {
+		args = args[1:]
+	}
+
+	fmt.Printf("\nType the cost of sending a flow unit along every arc:\n")
+	cost, err := ResolveMatrixWithSize(args, len(capacity.Rows), len(capacity.Rows))
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	source, sink, err := readFlowEnds()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nType the amount of flow, 0 for the max flow: \n")
+	amount, err := ReadFloat()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if amount == 0 {
+		amount = math.Inf(1)
+	}
+
+	res, err := flow.MinCostFlow(ctx, capacity, cost, source, sink, amount)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if res.Value < amount && !math.IsInf(amount, 1) {
+		fmt.Printf("\nThe network can't carry more than %v\n", matrix.RoundTo(res.Value, 2))
+	}
+
+	fmt.Printf("\nFlow of value %v:\n\n", matrix.RoundTo(res.Value, 2))
+	flows := res.Flow.Round()
+	flows.Print()
+
+	fmt.Printf("\nYour min cost: \n%v\n", matrix.RoundTo(res.Cost, 2))
+}
+
+func readFlowEnds() (source, sink int, err error) {
+	fmt.Printf("\nType the source node: \n")
+	if source, err = ReadPositiveInt(); err != nil {
+		return 0, 0, err
+	}
+
+	fmt.Printf("\nType the sink node: \n")
+	if sink, err = ReadPositiveInt(); err != nil {
+		return 0, 0, err
+	}
+
+	return source - 1, sink - 1, nil
+}
+
+func oneBased(nodes []int) []int {
+	res := make([]int, len(nodes))
+	for i, n := range nodes {
+		res[i] = n + 1
+	}
+
+	return res
+}
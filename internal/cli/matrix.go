@@ -1,14 +1,13 @@
 package cli
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
 	"github.com/hrvadl/algo/internal/cli/parse"
+	"github.com/hrvadl/algo/internal/games"
 	"github.com/hrvadl/algo/internal/matrix"
 )
 
@@ -18,33 +17,108 @@ func GetMatrix(rows, columns int) (matrix.Matrix, error) {
 	}
 
 	m := matrix.Matrix{
-		Rows: make([]matrix.Row, rows),
+		Rows: make([]matrix.Row, 0, rows),
 	}
 
-	scanner := bufio.NewScanner(os.Stdin)
 	fmt.Printf("\nType the elements in the row  with the space between each:\n")
-	for i := 0; i < rows && scanner.Scan(); i++ {
-		tokens := strings.Fields(scanner.Text())
+	if console.IsTerminal() {
+		fmt.Printf("Type %q to edit the previous row again.\n", UndoRowCommand)
+	}
 
-		if len(tokens) != columns {
-			return matrix.Matrix{}, fmt.Errorf("invalid amount of tokens, expected: %v", columns)
+	lines := make([]string, 0, rows)
+	var initial string
+	for len(m.Rows) < rows {
+		line, err := readInput(fmt.Sprintf("row %d> ", len(m.Rows)+1), initial)
+		if err != nil {
+			return matrix.Matrix{}, err
 		}
 
-		for _, token := range tokens {
-			num, err := strconv.ParseFloat(token, 64)
-			if err != nil {
+		initial = ""
+		if line == UndoRowCommand {
+			if len(m.Rows) == 0 {
+				PrintError(errors.New("there is no row to edit"))
+				continue
+			}
+
+			last := len(m.Rows) - 1
+			initial = lines[last]
+			lines, m.Rows = lines[:last], m.Rows[:last]
+			continue
+		}
+
+		row, err := ParseRow(line, columns)
+		if err != nil {
+			if !console.IsTerminal() {
 				return matrix.Matrix{}, err
 			}
 
-			m.Rows[i] = append(m.Rows[i], num)
+			PrintError(err)
+			initial = line
+			continue
 		}
+
+		lines = append(lines, line)
+		m.Rows = append(m.Rows, row)
 	}
 
 	return m, nil
 }
 
+func ParseRow(line string, columns int) (matrix.Row, error) {
+	tokens := strings.Fields(line)
+	if len(tokens) != columns {
+		return nil, fmt.Errorf("invalid amount of tokens, expected: %v", columns)
+	}
+
+	row := make(matrix.Row, 0, columns)
+	for _, token := range tokens {
+		num, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		row = append(row, num)
+	}
+
+	return row, nil
+}
+
+func GetProbabilities(n int) (matrix.Row, error) {
+	m, err := GetMatrix(1, n)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m.Rows) == 0 {
+		return nil, games.ErrProbabilityLength
+	}
+
+	p := m.Rows[0]
+	err = games.ValidateProbabilities(p, n)
+	if !errors.Is(err, games.ErrProbabilitySum) {
+		return p, err
+	}
+
+	fmt.Printf("\n%v. Do you want to normalize it? (yes/no)\n", err)
+	answer, rerr := ReadWord()
+	if rerr != nil || answer != "yes" {
+		return nil, err
+	}
+
+	return games.NormalizeProbabilities(p)
+}
+
 func GetNegativeRowFromExpression() (matrix.Row, bool, error) {
-	inequality, isEquation, err := GetRowFromExpression()
+	line, err := ReadLine()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return NegativeRowFromExpression(line)
+}
+
+func NegativeRowFromExpression(str string) (matrix.Row, bool, error) {
+	inequality, isEquation, err := parse.EquationOrInequationFromString(str)
 	if err != nil {
 		return nil, false, err
 	}
@@ -57,16 +131,29 @@ func GetNegativeRowFromExpression() (matrix.Row, bool, error) {
 }
 
 func GetRowFromExpression() (matrix.Row, bool, error) {
-	scanner := bufio.NewScanner(os.Stdin)
-	scanner.Scan()
-	return parse.EquationOrInequationFromString(scanner.Text())
+	line, err := ReadLine()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return parse.EquationOrInequationFromString(line)
 }
 
 func GetNegativeFunctionRow() (matrix.Row, error) {
-	r, err := GetFunctionRow()
+	line, err := ReadLine()
 	if err != nil {
 		return nil, err
 	}
+
+	return NegativeFunctionRow(line)
+}
+
+func NegativeFunctionRow(str string) (matrix.Row, error) {
+	r, err := FunctionRow(str)
+	if err != nil {
+		return nil, err
+	}
+
 	for i := 0; i < len(r)-1; i++ {
 		r[i] /= -1
 	}
@@ -75,7 +162,16 @@ func GetNegativeFunctionRow() (matrix.Row, error) {
 }
 
 func GetFunctionRow() (matrix.Row, error) {
-	r, err := GetRowFromOneSide()
+	line, err := ReadLine()
+	if err != nil {
+		return nil, err
+	}
+
+	return FunctionRow(line)
+}
+
+func FunctionRow(str string) (matrix.Row, error) {
+	r, err := parse.NewEvaluator(str).EvaluateFromString()
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +180,10 @@ func GetFunctionRow() (matrix.Row, error) {
 }
 
 func GetRowFromOneSide() (matrix.Row, error) {
-	scanner := bufio.NewScanner(os.Stdin)
-	scanner.Scan()
-	return parse.NewEvaluator(scanner.Text()).EvaluateFromString()
+	line, err := ReadLine()
+	if err != nil {
+		return nil, err
+	}
+
+	return parse.NewEvaluator(line).EvaluateFromString()
 }
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const (
+	WeightedScalarization = "weighted"
+	LexicographicOrder    = "lex"
+	GoalProgramming       = "goal"
+	ParetoEnumeration     = "pareto"
+)
+
+// HandleSolveMultiObjective takes Z of the model as the first objective
+// and reads the other ones with their goals.
+func HandleSolveMultiObjective(ctx context.Context, args []string) {
+	mode := WeightedScalarization
+	if len(args) > 0 {
+		switch args[0] {
+		case WeightedScalarization, LexicographicOrder, GoalProgramming, ParetoEnumeration:
+			mode, args = args[0], args[1:]
+		}
+	}
+
+	model, err := ResolveModel(args)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	problem, err := model.Problem()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	others, err := readObjectives()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	mp := lp.NewMultiProblem(problem, others...)
+	if err := mp.Validate(); err != nil {
+		PrintError(err)
+		return
+	}
+
+	switch mode {
+	case WeightedScalarization:
+		if err := readObjectiveWeights(mp); err != nil {
+			PrintError(err)
+			return
+		}
+
+		solution, err := lp.SolveWeighted(ctx, mp, inequations.SimplexMethod)
+		if err != nil {
+			PrintError(err)
+			return
+		}
+
+		printMultiSolution(mp, *solution)
+		fmt.Printf("\nYour weighted sum: \n%v\n", matrix.RoundTo(solution.Objective, 2))
+
+	case LexicographicOrder:
+		solution, err := lp.SolveLexicographic(ctx, mp, inequations.SimplexMethod)
+		if err != nil {
+			PrintError(err)
+			return
+		}
+
+		printMultiSolution(mp, *solution)
+
+	case GoalProgramming:
+		if err := readObjectiveTargets(mp); err != nil {
+			PrintError(err)
+			return
+		}
+
+		solution, err := lp.SolveGoals(ctx, mp, inequations.SimplexMethod)
+		if err != nil {
+			PrintError(err)
+			return
+		}
+
+		printMultiSolution(mp, *solution)
+		fmt.Printf("\nMissed the goals by: \n%v\n", matrix.RoundRowTo(solution.Deviations, 2))
+		fmt.Printf("\nYour weighted deviation: \n%v\n", matrix.RoundTo(solution.Objective, 2))
+
+	case ParetoEnumeration:
+		front, err := lp.ParetoFront(ctx, mp, inequations.SimplexMethod)
+		if err != nil {
+			PrintError(err)
+			return
+		}
+
+		for i, solution := range front {
+			fmt.Printf("\nEfficient point %d:", i+1)
+			printMultiSolution(mp, solution)
+		}
+	}
+}
+
+func readObjectives() ([]lp.Objective, error) {
+	fmt.Printf("\nInput the other objectives one per line, e.g. min 2x1+x2, empty line to finish: \n")
+	var res []lp.Objective
+	for {
+		line, err := ReadLine()
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(line) == "" {
+			return res, nil
+		}
+
+		o, err := lp.ParseObjective(line)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, o)
+	}
+}
+
+func readObjectiveWeights(mp lp.MultiProblem) error {
+	for k := range mp.Objectives {
+		fmt.Printf("\nInput the weight of %s: \n", mp.ObjectiveName(k))
+		w, err := ReadFloat()
+		if err != nil {
+			return err
+		}
+
+		mp.Objectives[k].Weight = w
+	}
+
+	return nil
+}
+
+func readObjectiveTargets(mp lp.MultiProblem) error {
+	for k, o := range mp.Objectives {
+		fmt.Printf("\nInput the target of %s (%s): \n", mp.ObjectiveName(k), o.Sense)
+		target, err := ReadFloat()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("\nInput the weight of missing it: \n")
+		w, err := ReadFloat()
+		if err != nil {
+			return err
+		}
+
+		mp.Objectives[k].Target, mp.Objectives[k].Weight = target, w
+	}
+
+	return nil
+}
+
+func printMultiSolution(mp lp.MultiProblem, solution lp.MultiSolution) {
+	fmt.Printf("\nYour optimal solution: \n%v\n", solution.Optimal)
+	for k, o := range mp.Objectives {
+		fmt.Printf("%s (%s): %v\n", mp.ObjectiveName(k), o.Sense, matrix.RoundTo(solution.Values[k], 2))
+	}
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/matrix"
+	"github.com/hrvadl/algo/internal/optimize"
+)
+
+var optimizeMethods = map[string]optimize.Method{
+	"gradient": optimize.GradientDescent,
+	"newton":   optimize.NewtonMethod,
+	"penalty":  optimize.PenaltyMethod,
+	"lagrange": optimize.LagrangeMethod,
+}
+
+// HandleOptimize reads a non-linear problem and prints every iteration
+// of the method. Without the method the unconstrained problems are
+// solved with the Newton's method and the constrained ones with penalty.
+func HandleOptimize(ctx context.Context, args []string) {
+	method, chosen := optimize.NewtonMethod, false
+	if len(args) > 0 {
+		if method, chosen = optimizeMethods[args[0]]; !chosen {
+			PrintError(optimize.ErrUnknownMethod)
+			return
+		}
+	}
+
+	fmt.Printf("\nInput f(x), e.g. (x1-1)^2+x1x2+exp(x2): \n")
+	objective, err := ReadLine()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nInput the constraints one per line, e.g. x1^2+x2^2 <= 4, empty line to finish: \n")
+	var constraints []string
+	for {
+		c, err := ReadLine()
+		if err != nil {
+			PrintError(err)
+			return
+		}
+
+		if strings.TrimSpace(c) == "" {
+			break
+		}
+
+		constraints = append(constraints, c)
+	}
+
+	fmt.Printf("\nDo you want to find min or max?\n")
+	goal, err := ReadWord()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	problem, err := optimize.Parse(goal, objective, constraints)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if problem.Start, err = readStart(len(problem.Variables)); err != nil {
+		PrintError(err)
+		return
+	}
+
+	if !chosen && len(constraints) != 0 {
+		method = optimize.PenaltyMethod
+	}
+
+	res, err := optimize.Solve(ctx, problem, method)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nIterations: \n%v\n", len(res.Trajectory))
+	fmt.Printf("\nYour optimal solution: \n%v\n", matrix.RoundRowTo(res.Optimal, 4))
+	if res.Multipliers != nil {
+		fmt.Printf("\nYour multipliers: \n%v\n", matrix.RoundRowTo(res.Multipliers, 4))
+	}
+	fmt.Printf("\nYour %s: \n%v\n", problem.Sense, matrix.RoundTo(res.Objective, 4))
+}
+
+func readStart(n int) ([]float64, error) {
+	fmt.Printf("\nInput the start point with the space between each of %d values, empty line for the origin: \n", n)
+	line, err := ReadLine()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	if len(fields) != n {
+		return nil, errors.New("start point should have a value per variable")
+	}
+
+	start := make([]float64, n)
+	for i, f := range fields {
+		if start[i], err = strconv.ParseFloat(f, 64); err != nil {
+			return nil, fmt.Errorf("invalid number %q", f)
+		}
+	}
+
+	return start, nil
+}
@@ -2,29 +2,16 @@ package cli
 
 import (
 	"errors"
-	"fmt"
 	"strconv"
-)
+	"strings"
 
-type Option = string
-
-const (
-	ExitOption                         = "exit"
-	InverseMatrixOption                = "inverse"
-	GetRankOption                      = "rank"
-	SolveLinearEquationOption          = "solve_equtaion"
-	SolveLinearInequationOption        = "solve_inequation"
-	SolveIntegerLinearInequationOption = "solve_integer"
-	SolveDoubledLinearInequationOption = "solve_doubled"
-	GetGameStrategies                  = "game_strategies"
-	SolveGameWithNature                = "game_with_nature"
-	HelpOption                         = "help"
-	ClearOption                        = "clear"
+	"github.com/hrvadl/algo/internal/games"
+	"github.com/hrvadl/algo/pkg/repl"
 )
 
 func ReadFloat() (float64, error) {
-	var input string
-	if _, err := fmt.Scanln(&input); err != nil {
+	input, err := ReadWord()
+	if err != nil {
 		return 0, err
 	}
 
@@ -36,9 +23,22 @@ func ReadFloat() (float64, error) {
 	return option, err
 }
 
+func ReadCoefficient() (float64, error) {
+	c, err := ReadFloat()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := games.ValidateCoefficient(c); err != nil {
+		return 0, err
+	}
+
+	return c, nil
+}
+
 func ReadInt() (int, error) {
-	var input string
-	if _, err := fmt.Scanln(&input); err != nil {
+	input, err := ReadWord()
+	if err != nil {
 		return 0, err
 	}
 
@@ -64,32 +64,35 @@ func ReadPositiveInt() (int, error) {
 }
 
 func ReadWord() (string, error) {
-	var str string
-	if _, err := fmt.Scanln(&str); err != nil {
+	line, err := ReadLine()
+	if err != nil {
+		return "", err
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) != 1 {
 		return "", errors.New("invalid input")
 	}
-	return str, nil
+
+	return fields[0], nil
 }
 
-func ChooseOption() (Option, error) {
-	option, err := ReadWord()
+func ReadLine() (string, error) {
+	line, err := readInput("", "")
+	if errors.Is(err, repl.ErrInterrupted) {
+		return "", err
+	}
+
 	if err != nil {
 		return "", errors.New("invalid input")
 	}
 
-	if option != InverseMatrixOption &&
-		option != GetRankOption &&
-		option != SolveLinearEquationOption &&
-		option != HelpOption &&
-		option != ExitOption &&
-		option != SolveLinearInequationOption &&
-		option != SolveIntegerLinearInequationOption &&
-		option != SolveDoubledLinearInequationOption &&
-		option != GetGameStrategies &&
-		option != SolveGameWithNature &&
-		option != ClearOption {
-		return "", errors.New("invalid options is chosen")
-	}
+	return line, nil
+}
 
-	return option, nil
+// readInput reads the line of the command input, the command timeout is
+// paused meanwhile.
+func readInput(prompt, initial string) (string, error) {
+	defer runner.Pause()()
+	return console.ReadLine(prompt, initial)
 }
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const (
+	ParametricRHS       = "rhs"
+	ParametricObjective = "objective"
+)
+
+// HandleParametric moves either the right hand sides or the objective
+// along the direction and prints where the optimal basis changes.
+func HandleParametric(ctx context.Context, args []string) {
+	kind := ParametricRHS
+	if len(args) > 0 && (args[0] == ParametricRHS || args[0] == ParametricObjective) {
+		kind, args = args[0], args[1:]
+	}
+
+	model, err := ResolveModel(args)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	problem, err := model.Problem()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	tableau, err := problem.Compile()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nJust confirmation. Your matrix: \n\n")
+	tableau.Matrix.Print()
+
+	names := problem.Variables
+	if kind == ParametricRHS {
+		names = make([]string, len(problem.Constraints))
+		for i := range names {
+			names[i] = problem.ConstraintName(i)
+		}
+	}
+
+	direction := make([]float64, len(names))
+	for i, name := range names {
+		fmt.Printf("\nInput the change of %s per unit of t: \n", name)
+		if direction[i], err = ReadFloat(); err != nil {
+			PrintError(err)
+			return
+		}
+	}
+
+	fmt.Printf("\nInput the first value of t: \n")
+	from, err := ReadFloat()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nInput the last value of t, inf for no limit: \n")
+	to, err := ReadFloat()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	parametric := tableau.ParametricRHS
+	if kind == ParametricObjective {
+		parametric = tableau.ParametricObjective
+	}
+
+	intervals, err := parametric(ctx, direction, from, to)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	for _, iv := range intervals {
+		fmt.Printf("\nt in [%v; %v], basis: %v\n", matrix.RoundTo(iv.From, 2), matrix.RoundTo(iv.To, 2), iv.Basis)
+		for i, v := range problem.Variables {
+			fmt.Printf("%s = %s\n", v, formatAffine(iv.Solution[i]))
+		}
+		fmt.Printf("%s = %s\n", problem.Sense, formatAffine(iv.Objective))
+	}
+
+	if last := intervals[len(intervals)-1]; last.To < to {
+		fmt.Printf("\nThe problem has no optimal solution for t > %v\n", matrix.RoundTo(last.To, 2))
+	}
+}
+
+func formatAffine(a inequations.Affine) string {
+	constant, slope := matrix.RoundTo(a.Constant, 2), matrix.RoundTo(a.Slope, 2)
+	switch {
+	case slope == 0:
+		return fmt.Sprint(constant)
+	case slope < 0:
+		return fmt.Sprintf("%v - %vt", constant, -slope)
+	}
+
+	return fmt.Sprintf("%v + %vt", constant, slope)
+}
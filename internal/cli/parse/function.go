@@ -0,0 +1,324 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"unicode"
+)
+
+var ErrEmptyFunction = errors.New("function should not be empty")
+
+// Function is an expression of x1, x2 and so on which, unlike the ones
+// the evaluator reads, may have non-linear terms: products, powers,
+// parentheses and the elementary functions.
+type Function struct {
+	String string
+	// Variables is the biggest index of the variables the expression uses.
+	Variables int
+	eval      func(x []float64) float64
+}
+
+// Eval calculates the function at x, the variables x doesn't have are 0.
+func (f Function) Eval(x []float64) float64 {
+	return f.eval(x)
+}
+
+var elementary = map[string]func(float64) float64{
+	"sin":  math.Sin,
+	"cos":  math.Cos,
+	"tan":  math.Tan,
+	"exp":  math.Exp,
+	"ln":   math.Log,
+	"log":  math.Log10,
+	"sqrt": math.Sqrt,
+	"abs":  math.Abs,
+}
+
+var constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+// FunctionFromString parses expressions like "x1^2+3x1x2-exp(-x2)/2".
+// A number or a variable followed by a variable or a parenthesis is
+// multiplied by it, "^" is right associative and binds tighter than the
+// unary minus.
+func FunctionFromString(str string) (Function, error) {
+	p := functionParser{expression: []rune(str)}
+	if p.skip(); !p.isNotOver() {
+		return Function{}, ErrEmptyFunction
+	}
+
+	eval, err := p.sum()
+	if err != nil {
+		return Function{}, err
+	}
+
+	if p.isNotOver() {
+		return Function{}, p.unexpected()
+	}
+
+	return Function{String: str, Variables: p.variables, eval: eval}, nil
+}
+
+type functionParser struct {
+	expression []rune
+	i          int
+	variables  int
+}
+
+type evaluation = func(x []float64) float64
+
+func (p *functionParser) sum() (evaluation, error) {
+	res, err := p.product()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isNotOver() && (p.peek() == '+' || p.peek() == '-') {
+		op := p.next()
+		right, err := p.product()
+		if err != nil {
+			return nil, err
+		}
+
+		left := res
+		if op == '+' {
+			res = func(x []float64) float64 { return left(x) + right(x) }
+		} else {
+			res = func(x []float64) float64 { return left(x) - right(x) }
+		}
+	}
+
+	return res, nil
+}
+
+func (p *functionParser) product() (evaluation, error) {
+	res, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isNotOver() {
+		op := p.peek()
+		switch {
+		case op == '*' || op == '/':
+			p.next()
+		case op == '(' || unicode.IsLetter(op) || unicode.IsDigit(op) || op == '.':
+			op = '*'
+		default:
+			return res, nil
+		}
+
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		left := res
+		if op == '*' {
+			res = func(x []float64) float64 { return left(x) * right(x) }
+		} else {
+			res = func(x []float64) float64 { return left(x) / right(x) }
+		}
+	}
+
+	return res, nil
+}
+
+func (p *functionParser) unary() (evaluation, error) {
+	if p.isNotOver() && (p.peek() == '-' || p.peek() == '+') {
+		op := p.next()
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		if op == '+' {
+			return operand, nil
+		}
+
+		return func(x []float64) float64 { return -operand(x) }, nil
+	}
+
+	return p.power()
+}
+
+func (p *functionParser) power() (evaluation, error) {
+	base, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isNotOver() || p.peek() != '^' {
+		return base, nil
+	}
+
+	p.next()
+	exponent, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(x []float64) float64 { return math.Pow(base(x), exponent(x)) }, nil
+}
+
+func (p *functionParser) primary() (evaluation, error) {
+	if !p.isNotOver() {
+		return nil, errors.New("unexpected end of the function")
+	}
+
+	switch r := p.peek(); {
+	case r == '(':
+		p.next()
+		return p.parenthesized()
+	case unicode.IsDigit(r) || r == '.':
+		return p.number()
+	case unicode.IsLetter(r):
+		return p.identifier()
+	}
+
+	return nil, p.unexpected()
+}
+
+func (p *functionParser) parenthesized() (evaluation, error) {
+	res, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isNotOver() || p.peek() != ')' {
+		return nil, errors.New("parenthesis is not closed")
+	}
+
+	p.next()
+	return res, nil
+}
+
+// number reads the decimal and scientific notations. The "e" is the
+// exponent only when a digit or a signed digit follows it, otherwise it
+// is the constant the number is multiplied by.
+func (p *functionParser) number() (evaluation, error) {
+	start := p.i
+	p.digits(true)
+	if p.exponent() {
+		p.i++
+		if r := p.expression[p.i]; r == '+' || r == '-' {
+			p.i++
+		}
+		p.digits(false)
+	}
+
+	num, err := strconv.ParseFloat(string(p.expression[start:p.i]), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", string(p.expression[start:p.i]))
+	}
+
+	p.skip()
+	return func([]float64) float64 { return num }, nil
+}
+
+func (p *functionParser) digits(point bool) {
+	for p.i < len(p.expression) && (unicode.IsDigit(p.expression[p.i]) || point && p.expression[p.i] == '.') {
+		p.i++
+	}
+}
+
+func (p *functionParser) exponent() bool {
+	i := p.i
+	if i >= len(p.expression) || p.expression[i] != 'e' && p.expression[i] != 'E' {
+		return false
+	}
+
+	if i++; i < len(p.expression) && (p.expression[i] == '+' || p.expression[i] == '-') {
+		i++
+	}
+
+	return i < len(p.expression) && unicode.IsDigit(p.expression[i])
+}
+
+// identifier reads a variable, which is x followed by its index, a
+// constant or an elementary function applied to the parenthesis. The
+// name ends before the next variable, so "ex1" is e*x1.
+func (p *functionParser) identifier() (evaluation, error) {
+	start := p.i
+	for p.i < len(p.expression) && unicode.IsLetter(p.expression[p.i]) {
+		if p.i > start && p.isVariable() {
+			break
+		}
+		p.i++
+	}
+	name := string(p.expression[start:p.i])
+
+	digits := p.i
+	p.digits(false)
+
+	if p.i > digits {
+		idx, err := strconv.Atoi(string(p.expression[digits:p.i]))
+		if name != "x" || err != nil || idx == 0 {
+			return nil, fmt.Errorf("invalid variable %q", string(p.expression[start:p.i]))
+		}
+
+		p.skip()
+		p.variables = max(p.variables, idx)
+		return func(x []float64) float64 {
+			if idx > len(x) {
+				return 0
+			}
+			return x[idx-1]
+		}, nil
+	}
+
+	p.skip()
+	if c, ok := constants[name]; ok {
+		return func([]float64) float64 { return c }, nil
+	}
+
+	f, ok := elementary[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+
+	if !p.isNotOver() || p.peek() != '(' {
+		return nil, fmt.Errorf("function %q should be followed by the parenthesis", name)
+	}
+
+	p.next()
+	arg, err := p.parenthesized()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(x []float64) float64 { return f(arg(x)) }, nil
+}
+
+func (p *functionParser) isVariable() bool {
+	return p.expression[p.i] == 'x' && p.i+1 < len(p.expression) && unicode.IsDigit(p.expression[p.i+1])
+}
+
+func (p *functionParser) isNotOver() bool {
+	return p.i < len(p.expression)
+}
+
+func (p *functionParser) peek() rune {
+	return p.expression[p.i]
+}
+
+func (p *functionParser) next() rune {
+	r := p.expression[p.i]
+	p.i++
+	p.skip()
+	return r
+}
+
+func (p *functionParser) skip() {
+	for p.i < len(p.expression) && unicode.IsSpace(p.expression[p.i]) {
+		p.i++
+	}
+}
+
+func (p *functionParser) unexpected() error {
+	return fmt.Errorf("unexpected %q at %d", p.expression[p.i], p.i+1)
+}
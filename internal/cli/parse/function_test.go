@@ -0,0 +1,127 @@
+package parse
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFunctionFromString(t *testing.T) {
+	tc := []struct {
+		name      string
+		str       string
+		x         []float64
+		expected  float64
+		variables int
+		err       error
+	}{
+		{
+			name:      "Should parse linear function",
+			str:       "3x1-2x2+1",
+			x:         []float64{2, 1},
+			expected:  5,
+			variables: 2,
+		},
+		{
+			name:      "Should parse powers and products",
+			str:       "x1^2 + 3x1x2 - x2^3",
+			x:         []float64{2, -1},
+			expected:  -1,
+			variables: 2,
+		},
+		{
+			name:      "Should apply unary minus after power",
+			str:       "-x1^2+2^-1",
+			x:         []float64{3},
+			expected:  -8.5,
+			variables: 1,
+		},
+		{
+			name:      "Should parse power as right associative",
+			str:       "2^3^2",
+			expected:  512,
+			variables: 0,
+		},
+		{
+			name:      "Should parse parentheses and division",
+			str:       "(x1+1)(x2-1)/4",
+			x:         []float64{1, 3},
+			expected:  1,
+			variables: 2,
+		},
+		{
+			name:      "Should parse elementary functions and constants",
+			str:       "exp(x1)+sin(pi*x2)+sqrt(x3)+ln(e)",
+			x:         []float64{0, 0.5, 4},
+			expected:  5,
+			variables: 3,
+		},
+		{
+			name:      "Should treat missing variables as zeros",
+			str:       "x1+x10",
+			x:         []float64{1},
+			expected:  1,
+			variables: 10,
+		},
+		{
+			name:      "Should parse decimal numbers",
+			str:       "0.5x1^2",
+			x:         []float64{2},
+			expected:  2,
+			variables: 1,
+		},
+		{
+			name:      "Should parse scientific notation",
+			str:       "1e-3x1+2.5e2+1E+1",
+			x:         []float64{1000},
+			expected:  261,
+			variables: 1,
+		},
+		{
+			name:      "Should multiply by e without exponent",
+			str:       "2e+ex1+pix1+3exp(0)",
+			x:         []float64{1},
+			expected:  3*math.E + math.Pi + 3,
+			variables: 1,
+		},
+		{
+			name: "Should reject empty function",
+			str:  "  ",
+			err:  ErrEmptyFunction,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f, err := FunctionFromString(tt.str)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			if f.Variables != tt.variables {
+				t.Fatalf("Expected to get: %v, got: %v", tt.variables, f.Variables)
+			}
+
+			if got := f.Eval(tt.x); math.Abs(got-tt.expected) > 1e-9 {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFunctionFromStringErrors(t *testing.T) {
+	tc := []string{"x1+", "(x1+x2", "foo(x1)", "sin x1", "x0", "x1)", "2*/x1", "x1+y1", "sin2", "pi2"}
+	for _, str := range tc {
+		t.Run(str, func(t *testing.T) {
+			t.Parallel()
+			if _, err := FunctionFromString(str); err == nil {
+				t.Fatalf("Expected to get error for %q, got: nil", str)
+			}
+		})
+	}
+}
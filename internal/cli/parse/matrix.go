@@ -0,0 +1,55 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// MatrixFromString parses matrix literals like "[1 2; 3 4]". Rows are
+// separated with ';', elements with spaces or commas.
+func MatrixFromString(str string) (matrix.Matrix, error) {
+	str = strings.TrimSpace(str)
+	str = strings.TrimPrefix(str, "[")
+	str = strings.TrimSuffix(str, "]")
+
+	if strings.TrimSpace(str) == "" {
+		return matrix.Matrix{}, errors.New("matrix should not be empty")
+	}
+
+	var m matrix.Matrix
+	for i, line := range strings.Split(str, ";") {
+		tokens := strings.FieldsFunc(line, func(r rune) bool {
+			return r == ' ' || r == ',' || r == '\t'
+		})
+
+		if len(m.Rows) > 0 && len(tokens) != len(m.Rows[0]) {
+			return matrix.Matrix{}, fmt.Errorf(
+				"invalid amount of tokens in row %d, expected: %v",
+				i+1,
+				len(m.Rows[0]),
+			)
+		}
+
+		if len(tokens) == 0 {
+			return matrix.Matrix{}, fmt.Errorf("row %d should not be empty", i+1)
+		}
+
+		row := make(matrix.Row, 0, len(tokens))
+		for _, token := range tokens {
+			num, err := strconv.ParseFloat(token, 64)
+			if err != nil {
+				return matrix.Matrix{}, fmt.Errorf("invalid number %q in row %d", token, i+1)
+			}
+
+			row = append(row, num)
+		}
+
+		m.Rows = append(m.Rows, row)
+	}
+
+	return m, nil
+}
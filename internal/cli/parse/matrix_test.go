@@ -0,0 +1,63 @@
+package parse
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestMatrixFromString(t *testing.T) {
+	tc := []struct {
+		name     string
+		str      string
+		expected []matrix.Row
+		wantErr  bool
+	}{
+		{
+			name:     "Should parse matrix with brackets correctly",
+			str:      "[1 2; 3 4]",
+			expected: []matrix.Row{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "Should parse matrix with commas correctly",
+			str:      "1, -2.5;3,4",
+			expected: []matrix.Row{{1, -2.5}, {3, 4}},
+		},
+		{
+			name:     "Should parse single row correctly",
+			str:      "[0.2 0.8]",
+			expected: []matrix.Row{{0.2, 0.8}},
+		},
+		{
+			name:    "Should fail on rows with different length",
+			str:     "[1 2; 3]",
+			wantErr: true,
+		},
+		{
+			name:    "Should fail on invalid number",
+			str:     "[1 a]",
+			wantErr: true,
+		},
+		{
+			name:    "Should fail on empty matrix",
+			str:     "[]",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(fmt.Sprintf("%s: %s", tt.name, tt.str), func(t *testing.T) {
+			t.Parallel()
+			actual, err := MatrixFromString(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v\ngot:%v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, actual.Rows) {
+				t.Fatalf("expected: %v\ngot:%v", tt.expected, actual.Rows)
+			}
+		})
+	}
+}
@@ -2,6 +2,10 @@ package cli
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/games"
+	"github.com/hrvadl/algo/internal/matrix"
 )
 
 func PrintStudentInfo() {
@@ -9,18 +13,37 @@ func PrintStudentInfo() {
 }
 
 func PrintHelp() {
+	PrintRegistryHelp(DefaultRegistry)
+}
+
+// PrintRegistryHelp aligns the usages by the longest help of the commands.
+func PrintRegistryHelp(r *Registry) {
+	var width int
+	for _, c := range r.Commands() {
+		width = max(width, len(c.Help+": "))
+	}
+
+	fmt.Println()
+	for _, c := range r.Commands() {
+		usage := c.Usage()
+		if len(c.Aliases) > 0 {
+			usage += fmt.Sprintf(" (%s)", strings.Join(c.Aliases, ", "))
+		}
+
+		fmt.Printf("%-*s%s\n", width, c.Help+":", usage)
+	}
 	fmt.Println()
-	fmt.Printf("Exit the program:                    %s\n", ExitOption)
-	fmt.Printf("Calculate inverse matrix:            %s\n", InverseMatrixOption)
-	fmt.Printf("Calculate rank of the matrix:        %s\n", GetRankOption)
-	fmt.Printf("Calculate linear equation system:    %s\n", SolveLinearEquationOption)
-	fmt.Printf("Calculate linear inequation:         %s\n", SolveLinearInequationOption)
-	fmt.Printf("Calculate integer linear inequation: %s\n", SolveIntegerLinearInequationOption)
-	fmt.Printf("Calculate doubled linear inequation: %s\n", SolveDoubledLinearInequationOption)
-	fmt.Printf("Get game strategies:                 %s\n", GetGameStrategies)
-	fmt.Printf("Solve game with nature:              %s\n", SolveGameWithNature)
-	fmt.Printf("Print this message:                  %s\n", HelpOption)
-	fmt.Printf("Clear the screen:                    %s\n", ClearOption)
+}
+
+func PrintCommandHelp(c Command) {
+	fmt.Printf("\n%s\nUsage: %s\n", c.Help, c.Usage())
+	if len(c.Aliases) > 0 {
+		fmt.Printf("Aliases: %s\n", strings.Join(c.Aliases, ", "))
+	}
+
+	for _, a := range c.Args {
+		fmt.Printf("  %-10s %s\n", a.Name, a.Help)
+	}
 	fmt.Println()
 }
 
@@ -31,3 +54,79 @@ func PrintError(err error) {
 func PrintExitMessage() {
 	fmt.Printf("\nThe program is terminated... Bye!\n")
 }
+
+func PrintConvergenceReport(r games.ConvergenceReport) {
+	fmt.Printf("\nTheoretical game weight: %v\n", r.Theoretical)
+	fmt.Printf("\n%10s %12s %12s\n", "Step", "Average win", "Deviation")
+	for _, p := range r.Points {
+		fmt.Printf(
+			"%10d %12v %12v\n",
+			p.Step,
+			matrix.RoundTo(p.Avg, 4),
+			matrix.RoundTo(p.Deviation, 4),
+		)
+	}
+
+	fmt.Printf("\nConvergence chart (* - average win, - - game weight, # - both):\n\n")
+	fmt.Print(games.RenderConvergenceChart(r, games.DefaultChartHeight))
+
+	printStrategyFrequencies("First player", "A", r.FirstPlayerFrequencies, r.FirstPlayerChiSquare)
+	printStrategyFrequencies("Second player", "B", r.SecondPlayerFrequencies, r.SecondPlayerChiSquare)
+	fmt.Println()
+}
+
+func printStrategyFrequencies(
+	player, prefix string,
+	freqs []games.StrategyFrequency,
+	chi games.ChiSquare,
+) {
+	fmt.Printf("\n%s strategies:\n", player)
+	fmt.Printf("%10s %10s %10s %10s\n", "Strategy", "Expected", "Empirical", "Observed")
+	for _, f := range freqs {
+		fmt.Printf(
+			"%10s %10v %10v %10d\n",
+			fmt.Sprintf("%s%d", prefix, f.Strategy),
+			matrix.RoundTo(f.Expected, 4),
+			matrix.RoundTo(f.Empirical, 4),
+			f.Observed,
+		)
+	}
+
+	fmt.Printf(
+		"Chi-square: %v, degrees of freedom: %d, p-value: %v\n",
+		matrix.RoundTo(chi.Statistic, 4),
+		chi.DegreesOfFreedom,
+		matrix.RoundTo(chi.PValue, 4),
+	)
+}
+
+func PrintCriteriaComparison(c games.CriteriaComparison) {
+	if len(c.Criteria) == 0 {
+		return
+	}
+
+	fmt.Printf("%-20s", "Criterion")
+	for i := range c.Criteria[0].Scores {
+		fmt.Printf("%12s", fmt.Sprintf("A%d", i))
+	}
+	fmt.Println()
+
+	for _, criterion := range c.Criteria {
+		name := criterion.Name
+		if criterion.Minimize {
+			name += " (min)"
+		}
+
+		fmt.Printf("%-20s", name)
+		for i, score := range criterion.Scores {
+			var mark string
+			if criterion.IsBest(i) {
+				mark = "*"
+			}
+			fmt.Printf("%12s", fmt.Sprintf("%v%s", matrix.RoundTo(score, 2), mark))
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("\n* - chosen strategy\n\n")
+}
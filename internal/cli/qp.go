@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hrvadl/algo/internal/matrix"
+	"github.com/hrvadl/algo/internal/qp"
+)
+
+// HandleSolveQuadratic takes Z of the model as the linear part c of the
+// objective 1/2*x'Qx + c'x and reads Q afterwards.
+func HandleSolveQuadratic(ctx context.Context, args []string) {
+	model, err := ResolveModel(args[:min(len(args), 1)])
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	problem, err := model.Problem()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	n := len(problem.Variables)
+	fmt.Printf("\nType the %dx%d matrix Q of the quadratic part 1/2*x'Qx:\n", n, n)
+	q, err := ResolveMatrixWithSize(args[min(len(args), 1):], n, n)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	solution, err := qp.Solve(ctx, qp.Problem{Problem: problem, Q: q})
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nYour optimal solution: \n%v\n", matrix.RoundRowTo(solution.Optimal, 2))
+	fmt.Printf("\nYour dual solution: \n%v\n", matrix.RoundRowTo(solution.Dual, 2))
+	fmt.Printf("\nYour %s: \n%v\n", problem.Sense, matrix.RoundTo(solution.Objective, 2))
+}
@@ -0,0 +1,14 @@
+package cli
+
+import "github.com/hrvadl/algo/pkg/command"
+
+type (
+	Handler  = command.Handler
+	Arg      = command.Arg
+	Command  = command.Command
+	Registry = command.Registry
+)
+
+func NewRegistry() *Registry {
+	return command.NewRegistry()
+}
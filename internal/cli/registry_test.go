@@ -0,0 +1,23 @@
+package cli
+
+import "testing"
+
+func TestDefaultRegistryHasBuiltins(t *testing.T) {
+	for _, name := range []string{
+		ExitOption,
+		InverseMatrixOption,
+		GetRankOption,
+		SolveLinearEquationOption,
+		SolveLinearInequationOption,
+		SolveIntegerLinearInequationOption,
+		SolveDoubledLinearInequationOption,
+		GetGameStrategies,
+		SolveGameWithNature,
+		HelpOption,
+		ClearOption,
+	} {
+		if _, ok := DefaultRegistry.Lookup(name); !ok {
+			t.Errorf("Expected command %s to be registered", name)
+		}
+	}
+}
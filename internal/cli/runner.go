@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+const CommandTimeoutEnv = "ALGO_COMMAND_TIMEOUT"
+
+var ErrCommandAborted = errors.New("command aborted")
+
+var runner = NewRunner(DefaultCommandTimeout())
+
+// Runner runs one command at a time under a cancellable context,
+// so a signal handler can abort it without killing the process. The
+// timeout counts only the time the command runs, not the one it waits
+// for the input.
+type Runner struct {
+	mu      sync.Mutex
+	timeout time.Duration
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	// timer cancels the command when the remaining time since started
+	// is over, it's stopped while the command is paused.
+	timer     *time.Timer
+	remaining time.Duration
+	started   time.Time
+}
+
+func NewRunner(timeout time.Duration) *Runner {
+	return &Runner{timeout: timeout}
+}
+
+func (r *Runner) Timeout() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.timeout
+}
+
+// SetTimeout sets the per-command timeout, zero disables it.
+func (r *Runner) SetTimeout(timeout time.Duration) error {
+	if timeout < 0 {
+		return errors.New("timeout should not be negative")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.timeout = timeout
+	return nil
+}
+
+func (r *Runner) Run(parent context.Context, fn func(ctx context.Context) error) error {
+	r.mu.Lock()
+	if r.cancel != nil {
+		r.mu.Unlock()
+		return errors.New("another command is already running")
+	}
+
+	timeout := r.timeout
+	ctx, cancelCause := context.WithCancelCause(parent)
+	cancel := func() { cancelCause(context.Canceled) }
+	if timeout > 0 {
+		r.remaining, r.started = timeout, time.Now()
+		r.timer = time.AfterFunc(timeout, func() { cancelCause(context.DeadlineExceeded) })
+	}
+
+	done := make(chan struct{})
+	r.cancel, r.done = cancel, done
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		if r.timer != nil {
+			r.timer.Stop()
+		}
+		r.cancel, r.done, r.timer = nil, nil, nil
+		r.mu.Unlock()
+		cancel()
+		close(done)
+	}()
+
+	err := fn(ctx)
+	switch {
+	case ctx.Err() == nil:
+	case errors.Is(context.Cause(ctx), context.DeadlineExceeded):
+		return fmt.Errorf("%w: timed out after %v", ErrCommandAborted, timeout)
+	default:
+		return ErrCommandAborted
+	}
+
+	return err
+}
+
+// Pause stops the timeout of the running command until the returned
+// function is called, so the time the input is typed doesn't count.
+func (r *Runner) Pause() (resume func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	timer := r.timer
+	if timer == nil || !timer.Stop() {
+		return func() {}
+	}
+
+	r.remaining -= time.Since(r.started)
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.timer != timer {
+			return
+		}
+
+		r.started = time.Now()
+		timer.Reset(max(r.remaining, 0))
+	}
+}
+
+// Cancel aborts the running command and reports whether there was one.
+func (r *Runner) Cancel() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancel == nil {
+		return false
+	}
+
+	r.cancel()
+	return true
+}
+
+// Wait blocks until the running command returns or the timeout expires.
+func (r *Runner) Wait(timeout time.Duration) bool {
+	r.mu.Lock()
+	done := r.done
+	r.mu.Unlock()
+	if done == nil {
+		return true
+	}
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+func DefaultCommandTimeout() time.Duration {
+	env := os.Getenv(CommandTimeoutEnv)
+	if env == "" {
+		return 0
+	}
+
+	timeout, err := time.ParseDuration(env)
+	if err != nil || timeout < 0 {
+		PrintError(fmt.Errorf("invalid %s value %q, timeout is disabled", CommandTimeoutEnv, env))
+		return 0
+	}
+
+	return timeout
+}
+
+func HandleTimeout(_ context.Context, args []string) {
+	if len(args) == 0 {
+		if timeout := runner.Timeout(); timeout > 0 {
+			fmt.Printf("\nCommand timeout: %v\n", timeout)
+		} else {
+			fmt.Printf("\nCommand timeout is disabled\n")
+		}
+		return
+	}
+
+	timeout, err := time.ParseDuration(args[0])
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if err := runner.SetTimeout(timeout); err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nCommand timeout is set to: %v\n", timeout)
+}
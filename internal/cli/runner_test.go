@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunnerRun(t *testing.T) {
+	errFailed := errors.New("failed")
+	tc := []struct {
+		name     string
+		timeout  time.Duration
+		fn       func(ctx context.Context) error
+		expected error
+	}{
+		{
+			name: "Should return command error",
+			fn: func(context.Context) error {
+				return errFailed
+			},
+			expected: errFailed,
+		},
+		{
+			name: "Should succeed without timeout",
+			fn: func(context.Context) error {
+				return nil
+			},
+		},
+		{
+			name:    "Should abort command after timeout",
+			timeout: time.Millisecond,
+			fn: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			expected: ErrCommandAborted,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := NewRunner(tt.timeout)
+			if err := r.Run(context.Background(), tt.fn); !errors.Is(err, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, err)
+			}
+
+			if r.Cancel() {
+				t.Fatalf("Expected no command to be running after Run returned")
+			}
+		})
+	}
+}
+
+func TestRunnerCancel(t *testing.T) {
+	r := NewRunner(0)
+	started := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		result <- r.Run(context.Background(), func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	}()
+
+	<-started
+	if !r.Cancel() {
+		t.Fatalf("Expected running command to be cancelled")
+	}
+
+	if !r.Wait(time.Second) {
+		t.Fatalf("Expected command to stop after cancellation")
+	}
+
+	if err := <-result; !errors.Is(err, ErrCommandAborted) {
+		t.Fatalf("Expected to get: %v, got: %v", ErrCommandAborted, err)
+	}
+}
+
+func TestRunnerPause(t *testing.T) {
+	r := NewRunner(50 * time.Millisecond)
+	err := r.Run(context.Background(), func(ctx context.Context) error {
+		resume := r.Pause()
+		time.Sleep(100 * time.Millisecond)
+		resume()
+
+		if err := ctx.Err(); err != nil {
+			t.Errorf("Expected paused command not to time out, got: %v", err)
+		}
+
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	if !errors.Is(err, ErrCommandAborted) {
+		t.Fatalf("Expected to get: %v, got: %v", ErrCommandAborted, err)
+	}
+
+	// there is no command to pause anymore
+	r.Pause()()
+}
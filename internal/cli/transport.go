@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hrvadl/algo/internal/matrix"
+	"github.com/hrvadl/algo/internal/transport"
+)
+
+var transportMethods = map[string]transport.Method{
+	"nw":    transport.NorthwestCorner,
+	"min":   transport.MinimumCost,
+	"vogel": transport.Vogel,
+}
+
+func HandleSolveTransport(ctx context.Context, args []string) {
+	method := transport.Vogel
+	if len(args) > 0 {
+		if m, ok := transportMethods[args[0]]; ok {
+			method, args = m, args[1:]
+		}
+	}
+
+	fmt.Printf("\nType the cost of shipping from every supplier (row) to every consumer (column):\n")
+	cost, err := ResolveMatrix(args)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if len(cost.Rows) == 0 || len(cost.Rows[0]) == 0 {
+		PrintError(transport.ErrEmptyProblem)
+		return
+	}
+
+	fmt.Printf("\nType the supply of every supplier:\n")
+	supply, err := GetMatrix(1, len(cost.Rows))
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nType the demand of every consumer:\n")
+	demand, err := GetMatrix(1, len(cost.Rows[0]))
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	solution, err := transport.Solve(ctx, transport.Problem{
+		Supply: supply.Rows[0],
+		Demand: demand.Rows[0],
+		Cost:   cost,
+	}, method)
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if solution.DummySupplier {
+		fmt.Printf("\nDemand exceeds supply, the last row is the dummy supplier\n")
+	}
+
+	if solution.DummyConsumer {
+		fmt.Printf("\nSupply exceeds demand, the last column is the dummy consumer\n")
+	}
+
+	fmt.Printf("\nInitial plan (%v method) with cost %v:\n\n", method, matrix.RoundTo(solution.Initial.Cost, 2))
+	initial := solution.Initial.Amounts.Round()
+	initial.Print()
+
+	fmt.Printf("\nOptimal plan after %d iterations:\n\n", solution.Iterations)
+	optimal := solution.Optimal.Amounts.Round()
+	optimal.Print()
+
+	fmt.Printf("\nSupplier potentials: \n%v\n", matrix.RoundRowTo(solution.U, 2))
+	fmt.Printf("\nConsumer potentials: \n%v\n", matrix.RoundRowTo(solution.V, 2))
+	fmt.Printf("\nYour min cost: \n%v\n", matrix.RoundTo(solution.Optimal.Cost, 2))
+}
@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/cli/parse"
+	"github.com/hrvadl/algo/internal/matrix"
+	"github.com/hrvadl/algo/internal/workspace"
+)
+
+const (
+	MaxGoal = workspace.MaxGoal
+	MinGoal = workspace.MinGoal
+)
+
+var session = workspace.New()
+
+func HandleLet(_ context.Context, args []string) {
+	name, value, hasValue := strings.Cut(strings.Join(args, " "), "=")
+	name = strings.TrimSpace(name)
+	if err := workspace.ValidateName(name); err != nil {
+		PrintError(err)
+		return
+	}
+
+	var (
+		m   matrix.Matrix
+		err error
+	)
+
+	if hasValue {
+		m, err = parse.MatrixFromString(value)
+	} else {
+		m, err = HandleGetMatrix()
+	}
+
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if err := session.SetMatrix(name, m); err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\n%s = \n", name)
+	m.Print()
+}
+
+func HandleLetModel(_ context.Context, args []string) {
+	if err := workspace.ValidateName(args[0]); err != nil {
+		PrintError(err)
+		return
+	}
+
+	model, err := ReadModel()
+	if err != nil {
+		PrintError(err)
+		return
+	}
+
+	if err := session.SetModel(args[0], model); err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nModel %s is saved\n", args[0])
+}
+
+func HandleListVariables(context.Context, []string) {
+	names := session.Names()
+	if len(names) == 0 {
+		fmt.Printf("\nWorkspace is empty\n")
+		return
+	}
+
+	fmt.Println()
+	for _, name := range names {
+		kind, _ := session.Kind(name)
+		switch kind {
+		case workspace.MatrixKind:
+			m, _ := session.Matrix(name)
+			cols, rows := m.GetDimensions()
+			fmt.Printf("%-10s matrix %dx%d\n", name, rows, cols)
+		case workspace.ModelKind:
+			model, _ := session.Model(name)
+			fmt.Printf(
+				"%-10s lp     %s z = %s, %d constraints\n",
+				name,
+				model.Goal,
+				model.Objective,
+				len(model.Constraints),
+			)
+		}
+	}
+}
+
+func HandleDeleteVariable(_ context.Context, args []string) {
+	if err := session.Delete(args[0]); err != nil {
+		PrintError(err)
+	}
+}
+
+func HandleSaveWorkspace(_ context.Context, args []string) {
+	if err := session.Save(args[0]); err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nWorkspace is saved to %s\n", args[0])
+}
+
+func HandleLoadWorkspace(_ context.Context, args []string) {
+	if err := session.Load(args[0]); err != nil {
+		PrintError(err)
+		return
+	}
+
+	fmt.Printf("\nWorkspace is loaded from %s\n", args[0])
+}
+
+func ResolveMatrix(args []string) (matrix.Matrix, error) {
+	if len(args) == 0 {
+		return HandleGetMatrix()
+	}
+
+	return session.Matrix(args[0])
+}
+
+func ResolveSquareMatrix(args []string) (matrix.Matrix, error) {
+	if len(args) == 0 {
+		fmt.Println("\nType the size of your matrix: ")
+		size, err := ReadInt()
+		if err != nil {
+			return matrix.Matrix{}, err
+		}
+
+		return GetMatrix(size, size)
+	}
+
+	m, err := session.Matrix(args[0])
+	if err != nil {
+		return matrix.Matrix{}, err
+	}
+
+	if !m.IsSquare() {
+		return matrix.Matrix{}, fmt.Errorf("matrix %s should be square", args[0])
+	}
+
+	return m, nil
+}
+
+func ResolveMatrixWithSize(args []string, rows, cols int) (matrix.Matrix, error) {
+	if len(args) == 0 {
+		return GetMatrix(rows, cols)
+	}
+
+	m, err := session.Matrix(args[0])
+	if err != nil {
+		return matrix.Matrix{}, err
+	}
+
+	if c, r := m.GetDimensions(); r != rows || c != cols {
+		return matrix.Matrix{}, fmt.Errorf("matrix %s should be %dx%d", args[0], rows, cols)
+	}
+
+	return m, nil
+}
+
+func ResolveModel(args []string) (workspace.Model, error) {
+	if len(args) == 0 {
+		return ReadModel()
+	}
+
+	return session.Model(args[0])
+}
+
+func ReadModel() (workspace.Model, error) {
+	fmt.Printf("\nInput Z: \n")
+	objective, err := ReadLine()
+	if err != nil {
+		return workspace.Model{}, err
+	}
+
+	if _, err := NegativeFunctionRow(objective); err != nil {
+		return workspace.Model{}, err
+	}
+
+	fmt.Printf("\nInput amount of inequation limitations: \n")
+	n, err := ReadPositiveInt()
+	if err != nil {
+		return workspace.Model{}, err
+	}
+
+	model := workspace.Model{
+		Objective:   objective,
+		Constraints: make([]string, 0, n),
+	}
+
+	for range n {
+		fmt.Printf("\nInput the inequation: \n")
+		constraint, err := ReadLine()
+		if err != nil {
+			return workspace.Model{}, err
+		}
+
+		if _, _, err := NegativeRowFromExpression(constraint); err != nil {
+			return workspace.Model{}, err
+		}
+
+		model.Constraints = append(model.Constraints, constraint)
+	}
+
+	fmt.Printf("\nInput the bounds of the variables one per line, e.g. x1 <= 5 or x2 free, empty line to finish: \n")
+	for {
+		bound, err := ReadLine()
+		if err != nil {
+			return workspace.Model{}, err
+		}
+
+		if strings.TrimSpace(bound) == "" {
+			break
+		}
+
+		model.Bounds = append(model.Bounds, bound)
+	}
+
+	fmt.Printf("\nDo you want to find min or max?\n")
+	if model.Goal, err = ReadWord(); err != nil {
+		return workspace.Model{}, err
+	}
+
+	if model.Goal != MinGoal && model.Goal != MaxGoal {
+		return workspace.Model{}, workspace.ErrInvalidGoal
+	}
+
+	return model, nil
+}
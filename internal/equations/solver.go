@@ -1,14 +1,22 @@
 package equations
 
-import "github.com/hrvadl/algo/internal/matrix"
+import (
+	"context"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
 
 type EquationSystem struct {
 	A matrix.Matrix
 	B matrix.Matrix
 }
 
-func SolveSystem(s EquationSystem) []float64 {
-	swapped, _, _ := s.A.SwapAll()
+func SolveSystem(ctx context.Context, s EquationSystem) ([]float64, error) {
+	swapped, _, err := s.A.SwapAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	res := make([]float64, 0, len(swapped.Rows))
 
 	for _, row := range swapped.Rows {
@@ -19,5 +27,5 @@ func SolveSystem(s EquationSystem) []float64 {
 		res = append(res, matrix.RoundTo(x, 2))
 	}
 
-	return res
+	return res, nil
 }
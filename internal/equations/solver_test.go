@@ -1,6 +1,7 @@
 package equations
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -58,7 +59,12 @@ func TestSolveSystem(t *testing.T) {
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			if actual := SolveSystem(tt.sys); !reflect.DeepEqual(actual, tt.expected) {
+			actual, err := SolveSystem(context.Background(), tt.sys)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(actual, tt.expected) {
 				t.Errorf("expected: %v, got: %v", tt.expected, actual)
 			}
 		})
@@ -0,0 +1,244 @@
+package flow
+
+import (
+	"context"
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+type Algorithm uint8
+
+const (
+	Dinic Algorithm = iota
+	EdmondsKarp
+)
+
+func (a Algorithm) String() string {
+	switch a {
+	case Dinic:
+		return "Dinic"
+	case EdmondsKarp:
+		return "Edmonds-Karp"
+	default:
+		return "unknown"
+	}
+}
+
+// Cut is the minimum cut found after the max flow: Source holds the
+// nodes reachable from the source in the residual network.
+type Cut struct {
+	Source   []int
+	Edges    []Edge
+	Capacity float64
+}
+
+type MaxFlowResult struct {
+	Value float64
+	Flow  matrix.Matrix
+	Cut   Cut
+}
+
+// MaxFlow finds the maximum flow for the capacity matrix.
+func MaxFlow(
+	ctx context.Context,
+	capacity matrix.Matrix,
+	source, sink int,
+	algorithm Algorithm,
+) (*MaxFlowResult, error) {
+	g, err := FromCapacity(capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := g.MaxFlow(ctx, source, sink, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaxFlowResult{Value: value, Flow: g.Flows(), Cut: g.MinCut(source)}, nil
+}
+
+// MaxFlow pushes the maximum flow from the source to the sink on top
+// of the current flows and returns the total value leaving the source.
+func (g *Network) MaxFlow(
+	ctx context.Context,
+	source, sink int,
+	algorithm Algorithm,
+) (float64, error) {
+	if err := g.validateEnds(source, sink); err != nil {
+		return 0, err
+	}
+
+	var err error
+	switch algorithm {
+	case Dinic:
+		err = g.dinic(ctx, source, sink)
+	case EdmondsKarp:
+		err = g.edmondsKarp(ctx, source, sink)
+	default:
+		return 0, ErrUnknownAlgorithm
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return g.outflow(source), nil
+}
+
+// MinCut extracts the cut saturated by the current max flow.
+func (g *Network) MinCut(source int) Cut {
+	reachable := g.reachable(source)
+	var cut Cut
+	for n, ok := range reachable {
+		if ok {
+			cut.Source = append(cut.Source, n)
+		}
+	}
+
+	for _, e := range g.Edges() {
+		if reachable[e.From] && !reachable[e.To] {
+			cut.Edges = append(cut.Edges, e)
+			cut.Capacity += e.Capacity
+		}
+	}
+
+	return cut
+}
+
+func (g *Network) edmondsKarp(ctx context.Context, source, sink int) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		prev := g.bfs(source)
+		if prev[sink] < 0 {
+			return nil
+		}
+
+		amount := math.Inf(1)
+		for n := sink; n != source; n = g.edges[prev[n]].From {
+			amount = min(amount, g.edges[prev[n]].residual())
+		}
+
+		for n := sink; n != source; n = g.edges[prev[n]].From {
+			g.push(prev[n], amount)
+		}
+	}
+}
+
+// bfs returns the arc used to reach every node, -1 if it's unreachable.
+func (g *Network) bfs(source int) []int {
+	prev := make([]int, g.Nodes())
+	for i := range prev {
+		prev[i] = -1
+	}
+
+	visited := make([]bool, g.Nodes())
+	visited[source] = true
+	queue := []int{source}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, id := range g.adj[n] {
+			e := g.edges[id]
+			if visited[e.To] || e.residual() <= eps {
+				continue
+			}
+
+			visited[e.To] = true
+			prev[e.To] = id
+			queue = append(queue, e.To)
+		}
+	}
+
+	return prev
+}
+
+func (g *Network) dinic(ctx context.Context, source, sink int) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		level := g.levels(source)
+		if level[sink] < 0 {
+			return nil
+		}
+
+		next := make([]int, g.Nodes())
+		for {
+			pushed := g.augment(source, sink, math.Inf(1), level, next)
+			if pushed <= eps {
+				break
+			}
+		}
+	}
+}
+
+func (g *Network) levels(source int) []int {
+	level := make([]int, g.Nodes())
+	for i := range level {
+		level[i] = -1
+	}
+
+	level[source] = 0
+	queue := []int{source}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, id := range g.adj[n] {
+			e := g.edges[id]
+			if level[e.To] < 0 && e.residual() > eps {
+				level[e.To] = level[n] + 1
+				queue = append(queue, e.To)
+			}
+		}
+	}
+
+	return level
+}
+
+// augment sends a blocking flow along the level graph, next remembers
+// the first arc of every node which is not saturated yet.
+func (g *Network) augment(n, sink int, limit float64, level, next []int) float64 {
+	if n == sink {
+		return limit
+	}
+
+	for ; next[n] < len(g.adj[n]); next[n]++ {
+		id := g.adj[n][next[n]]
+		e := g.edges[id]
+		if level[e.To] != level[n]+1 || e.residual() <= eps {
+			continue
+		}
+
+		if pushed := g.augment(e.To, sink, min(limit, e.residual()), level, next); pushed > eps {
+			g.push(id, pushed)
+			return pushed
+		}
+	}
+
+	return 0
+}
+
+func (g *Network) reachable(source int) []bool {
+	prev := g.bfs(source)
+	res := make([]bool, g.Nodes())
+	for n := range res {
+		res[n] = n == source || prev[n] >= 0
+	}
+
+	return res
+}
+
+func (g *Network) outflow(n int) float64 {
+	var sum float64
+	for _, id := range g.adj[n] {
+		sum += g.edges[id].Flow
+	}
+
+	return sum
+}
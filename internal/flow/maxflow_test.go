@@ -0,0 +1,241 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// textbook is the CLRS network with the max flow of 23.
+func textbook() matrix.Matrix {
+	return matrix.Matrix{Rows: []matrix.Row{
+		{0, 16, 13, 0, 0, 0},
+		{0, 0, 0, 12, 0, 0},
+		{0, 4, 0, 0, 14, 0},
+		{0, 0, 9, 0, 0, 20},
+		{0, 0, 0, 7, 0, 4},
+		{0, 0, 0, 0, 0, 0},
+	}}
+}
+
+func TestMaxFlow(t *testing.T) {
+	tc := []struct {
+		name     string
+		capacity matrix.Matrix
+		source   int
+		sink     int
+		value    float64
+		cut      []int
+		err      error
+	}{
+		{
+			name:     "Should find max flow",
+			capacity: textbook(),
+			sink:     5,
+			value:    23,
+			cut:      []int{0, 1, 2, 4},
+		},
+		{
+			name:     "Should find max flow with antiparallel arcs",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{0, 2, 3, 0}, {1, 0, 1, 2}, {0, 1, 0, 1}, {0, 0, 0, 0}}},
+			sink:     3,
+			value:    3,
+			cut:      []int{0, 1, 2},
+		},
+		{
+			name:     "Should find zero flow for unreachable sink",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{0, 2, 0}, {0, 0, 0}, {0, 5, 0}}},
+			sink:     2,
+			cut:      []int{0, 1},
+		},
+		{
+			name:     "Should not allow same source and sink",
+			capacity: textbook(),
+			source:   1,
+			sink:     1,
+			err:      ErrSameNodes,
+		},
+		{
+			name:     "Should not allow sink out of network",
+			capacity: textbook(),
+			sink:     6,
+			err:      ErrInvalidNode,
+		},
+	}
+
+	for _, alg := range []Algorithm{Dinic, EdmondsKarp} {
+		for _, tt := range tc {
+			t.Run(fmt.Sprintf("%s: %s", alg, tt.name), func(t *testing.T) {
+				t.Parallel()
+				got, err := MaxFlow(context.Background(), tt.capacity, tt.source, tt.sink, alg)
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+				}
+
+				if err != nil {
+					return
+				}
+
+				if math.Abs(got.Value-tt.value) > eps {
+					t.Fatalf("Expected to get: %v, got: %v", tt.value, got.Value)
+				}
+
+				if math.Abs(got.Cut.Capacity-tt.value) > eps {
+					t.Fatalf("Expected cut capacity: %v, got: %v", tt.value, got.Cut.Capacity)
+				}
+
+				if !reflect.DeepEqual(got.Cut.Source, tt.cut) {
+					t.Fatalf("Expected to get cut: %v, got: %v", tt.cut, got.Cut.Source)
+				}
+
+				checkFlow(t, tt.capacity, got.Flow, tt.source, tt.sink, got.Value)
+			})
+		}
+	}
+}
+
+func TestMaxFlowMatchesLinearProblem(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(1))
+	for i := range 20 {
+		capacity := randomCapacity(r, 5)
+		expected, err := lp.Solve(context.Background(), maxFlowProblem(capacity, 0, 4), inequations.SimplexMethod)
+		if err != nil {
+			t.Fatalf("Expected to get no error, got: %v", err)
+		}
+
+		for _, alg := range []Algorithm{Dinic, EdmondsKarp} {
+			got, err := MaxFlow(context.Background(), capacity, 0, 4, alg)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if math.Abs(got.Value-expected.Objective) > lp.Tolerance {
+				t.Fatalf("%d, %s: Expected to get: %v, got: %v", i, alg, expected.Objective, got.Value)
+			}
+		}
+	}
+}
+
+func TestMaxFlowCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := MaxFlow(ctx, textbook(), 0, 5, Dinic); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get error: %v, got: %v", context.Canceled, err)
+	}
+}
+
+func checkFlow(t *testing.T, capacity, flow matrix.Matrix, source, sink int, value float64) {
+	t.Helper()
+	for i, row := range flow.Rows {
+		balance := 0.0
+		for j, f := range row {
+			if f < -eps || f > capacity.Rows[i][j]+eps {
+				t.Fatalf("Expected flow %d->%d to fit the capacity, got: %v", i, j, f)
+			}
+			balance += f - flow.Rows[j][i]
+		}
+
+		expected := 0.0
+		switch i {
+		case source:
+			expected = value
+		case sink:
+			expected = -value
+		}
+
+		if math.Abs(balance-expected) > eps {
+			t.Fatalf("Expected node %d to have balance: %v, got: %v", i, expected, balance)
+		}
+	}
+}
+
+func randomCapacity(r *rand.Rand, n int) matrix.Matrix {
+	m := matrix.Matrix{Rows: make([]matrix.Row, n)}
+	for i := range m.Rows {
+		m.Rows[i] = make(matrix.Row, n)
+		for j := range m.Rows[i] {
+			if i != j && r.Intn(3) > 0 {
+				m.Rows[i][j] = float64(r.Intn(10))
+			}
+		}
+	}
+
+	return m
+}
+
+// arcs lists the arcs of the capacity matrix in the order of LP variables.
+func arcs(capacity matrix.Matrix) [][2]int {
+	var res [][2]int
+	for i, row := range capacity.Rows {
+		for j, c := range row {
+			if i != j && c != 0 {
+				res = append(res, [2]int{i, j})
+			}
+		}
+	}
+
+	return res
+}
+
+// equal writes the equality as two inequalities, the doubled tableau
+// can't always get rid of the equality rows.
+func equal(coefficients []float64, rhs float64) []lp.Constraint {
+	return []lp.Constraint{
+		{Coefficients: coefficients, Relation: lp.LessEqual, RHS: rhs},
+		{Coefficients: coefficients, Relation: lp.GreaterEqual, RHS: rhs},
+	}
+}
+
+// flowProblem has the capacity and conservation constraints, the
+// objective is left to the caller.
+func flowProblem(capacity matrix.Matrix, source, sink int) lp.Problem {
+	edges := arcs(capacity)
+	var res lp.Problem
+	for k, e := range edges {
+		res.Variables = append(res.Variables, fmt.Sprintf("f%d_%d", e[0]+1, e[1]+1))
+		c := lp.Constraint{Coefficients: make([]float64, len(edges)), Relation: lp.LessEqual, RHS: capacity.Rows[e[0]][e[1]]}
+		c.Coefficients[k] = 1
+		res.Constraints = append(res.Constraints, c)
+	}
+
+	for n := range capacity.Rows {
+		if n == source || n == sink {
+			continue
+		}
+
+		res.Constraints = append(res.Constraints, equal(balance(edges, n), 0)...)
+	}
+
+	return res
+}
+
+func maxFlowProblem(capacity matrix.Matrix, source, sink int) lp.Problem {
+	res := flowProblem(capacity, source, sink)
+	res.Sense = lp.Maximize
+	res.Objective = balance(arcs(capacity), source)
+	return res
+}
+
+func balance(edges [][2]int, n int) []float64 {
+	res := make([]float64, len(edges))
+	for k, e := range edges {
+		switch n {
+		case e[0]:
+			res[k] = 1
+		case e[1]:
+			res[k] = -1
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,118 @@
+package flow
+
+import (
+	"context"
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+type MinCostResult struct {
+	Value float64
+	Cost  float64
+	Flow  matrix.Matrix
+}
+
+// MinCostFlow sends the amount of flow from the source to the sink for
+// the least cost, the infinite amount means the min cost max flow.
+func MinCostFlow(
+	ctx context.Context,
+	capacity, cost matrix.Matrix,
+	source, sink int,
+	amount float64,
+) (*MinCostResult, error) {
+	g, err := FromCapacityAndCost(capacity, cost)
+	if err != nil {
+		return nil, err
+	}
+
+	value, total, err := g.MinCostFlow(ctx, source, sink, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MinCostResult{Value: value, Cost: total, Flow: g.Flows()}, nil
+}
+
+// MinCostFlow uses the successive shortest paths: the flow is always
+// pushed along the cheapest path of the residual network, so it stays
+// optimal for its value. The network should carry no flow beforehand.
+func (g *Network) MinCostFlow(
+	ctx context.Context,
+	source, sink int,
+	amount float64,
+) (value, cost float64, err error) {
+	if err := g.validateEnds(source, sink); err != nil {
+		return 0, 0, err
+	}
+
+	if amount < 0 || math.IsNaN(amount) {
+		return 0, 0, ErrInvalidCapacity
+	}
+
+	for amount-value > eps {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
+		}
+
+		prev, err := g.shortestPaths(source)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if prev[sink] < 0 {
+			break
+		}
+
+		pushed := amount - value
+		for n := sink; n != source; n = g.edges[prev[n]].From {
+			pushed = min(pushed, g.edges[prev[n]].residual())
+		}
+
+		for n := sink; n != source; n = g.edges[prev[n]].From {
+			g.push(prev[n], pushed)
+			cost += pushed * g.edges[prev[n]].Cost
+		}
+
+		value += pushed
+	}
+
+	return value, cost, nil
+}
+
+// shortestPaths is Bellman-Ford over the residual network, it returns
+// the last arc of the cheapest path to every node or -1 if there's none.
+func (g *Network) shortestPaths(source int) ([]int, error) {
+	dist := make([]float64, g.Nodes())
+	prev := make([]int, g.Nodes())
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		prev[i] = -1
+	}
+
+	dist[source] = 0
+	for round := range g.Nodes() {
+		changed := false
+		for id, e := range g.edges {
+			if e.residual() <= eps || math.IsInf(dist[e.From], 1) {
+				continue
+			}
+
+			if d := dist[e.From] + e.Cost; d < dist[e.To]-eps {
+				dist[e.To] = d
+				prev[e.To] = id
+				changed = true
+			}
+		}
+
+		if !changed {
+			return prev, nil
+		}
+
+		if round == g.Nodes()-1 {
+			return nil, ErrNegativeCycle
+		}
+	}
+
+	return prev, nil
+}
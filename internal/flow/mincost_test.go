@@ -0,0 +1,132 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestMinCostFlow(t *testing.T) {
+	capacity := matrix.Matrix{Rows: []matrix.Row{{0, 4, 2, 0}, {0, 0, 2, 3}, {0, 0, 0, 5}, {0, 0, 0, 0}}}
+	cost := matrix.Matrix{Rows: []matrix.Row{{0, 2, 2, 0}, {0, 0, 1, 3}, {0, 0, 0, 1}, {0, 0, 0, 0}}}
+
+	tc := []struct {
+		name     string
+		capacity matrix.Matrix
+		cost     matrix.Matrix
+		amount   float64
+		value    float64
+		total    float64
+		err      error
+	}{
+		{
+			name:     "Should send the amount for the least cost",
+			capacity: capacity,
+			cost:     cost,
+			amount:   5,
+			value:    5,
+			total:    19,
+		},
+		{
+			name:     "Should find min cost max flow",
+			capacity: capacity,
+			cost:     cost,
+			amount:   math.Inf(1),
+			value:    6,
+			total:    24,
+		},
+		{
+			name:     "Should use negative costs",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{0, 1, 1}, {0, 0, 1}, {0, 0, 0}}},
+			cost:     matrix.Matrix{Rows: []matrix.Row{{0, 1, 1}, {0, 0, -3}, {0, 0, 0}}},
+			amount:   1,
+			value:    1,
+			total:    -2,
+		},
+		{
+			name:     "Should not allow negative cycle",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{0, 1, 0}, {0, 0, 1}, {0, 1, 0}}},
+			cost:     matrix.Matrix{Rows: []matrix.Row{{0, 1, 0}, {0, 0, -5}, {0, 1, 0}}},
+			amount:   1,
+			err:      ErrNegativeCycle,
+		},
+		{
+			name:     "Should not allow negative amount",
+			capacity: capacity,
+			cost:     cost,
+			amount:   -1,
+			err:      ErrInvalidCapacity,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sink := len(tt.capacity.Rows) - 1
+			got, err := MinCostFlow(context.Background(), tt.capacity, tt.cost, 0, sink, tt.amount)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if math.Abs(got.Value-tt.value) > eps || math.Abs(got.Cost-tt.total) > eps {
+				t.Fatalf("Expected to get: %v for %v, got: %v for %v", tt.value, tt.total, got.Value, got.Cost)
+			}
+
+			checkFlow(t, tt.capacity, got.Flow, 0, sink, got.Value)
+		})
+	}
+}
+
+func TestMinCostFlowMatchesLinearProblem(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(1))
+	for i := range 20 {
+		capacity, cost := randomCapacity(r, 5), randomCapacity(r, 5)
+		maxFlow, err := MaxFlow(context.Background(), capacity, 0, 4, Dinic)
+		if err != nil {
+			t.Fatalf("Expected to get no error, got: %v", err)
+		}
+
+		amount := math.Floor(maxFlow.Value / 2)
+		got, err := MinCostFlow(context.Background(), capacity, cost, 0, 4, amount)
+		if err != nil {
+			t.Fatalf("Expected to get no error, got: %v", err)
+		}
+
+		problem := flowProblem(capacity, 0, 4)
+		problem.Sense = lp.Minimize
+		for _, e := range arcs(capacity) {
+			problem.Objective = append(problem.Objective, cost.Rows[e[0]][e[1]])
+		}
+		problem.Constraints = append(problem.Constraints, equal(balance(arcs(capacity), 0), amount)...)
+
+		expected, err := lp.Solve(context.Background(), problem, inequations.SimplexMethod)
+		if err != nil {
+			t.Fatalf("Expected to get no error, got: %v", err)
+		}
+
+		if math.Abs(got.Cost-expected.Objective) > lp.Tolerance {
+			t.Fatalf("%d: Expected to get: %v, got: %v", i, expected.Objective, got.Cost)
+		}
+	}
+}
+
+func TestMinCostFlowCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := MinCostFlow(ctx, textbook(), matrix.Matrix{}, 0, 5, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get error: %v, got: %v", context.Canceled, err)
+	}
+}
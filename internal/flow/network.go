@@ -0,0 +1,176 @@
+package flow
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// eps treats the residual capacities left after subtractions as zero.
+const eps = 1e-9
+
+var (
+	ErrNotSquare        = errors.New("matrix should be square")
+	ErrInvalidNode      = errors.New("node is out of the network")
+	ErrSameNodes        = errors.New("source and sink should differ")
+	ErrInvalidCapacity  = errors.New("capacity should be a finite non-negative number")
+	ErrInvalidCost      = errors.New("cost should be a finite number")
+	ErrNegativeCycle    = errors.New("network has a cycle of negative cost")
+	ErrUnknownAlgorithm = errors.New("unknown max flow algorithm")
+)
+
+// Edge is an arc of the network, every arc is stored together with
+// its reverse residual arc.
+type Edge struct {
+	From     int
+	To       int
+	Capacity float64
+	Cost     float64
+	Flow     float64
+}
+
+func (e Edge) residual() float64 {
+	return e.Capacity - e.Flow
+}
+
+type Network struct {
+	edges []Edge
+	adj   [][]int
+}
+
+func NewNetwork(nodes int) *Network {
+	return &Network{adj: make([][]int, nodes)}
+}
+
+// FromCapacity builds the network from the square capacity matrix,
+// zeros mean there is no arc and the diagonal is ignored.
+func FromCapacity(capacity matrix.Matrix) (*Network, error) {
+	return FromCapacityAndCost(capacity, matrix.Matrix{})
+}
+
+// FromCapacityAndCost is FromCapacity with the cost of a flow unit for
+// every arc, the empty cost matrix means zero costs.
+func FromCapacityAndCost(capacity, cost matrix.Matrix) (*Network, error) {
+	n := len(capacity.Rows)
+	if n == 0 || !capacity.IsSquare() {
+		return nil, fmt.Errorf("capacity %w", ErrNotSquare)
+	}
+
+	if len(cost.Rows) != 0 && (!cost.IsSquare() || len(cost.Rows) != n) {
+		return nil, fmt.Errorf("cost %w and match the capacity", ErrNotSquare)
+	}
+
+	g := NewNetwork(n)
+	for i, row := range capacity.Rows {
+		for j, c := range row {
+			if i == j || c == 0 {
+				continue
+			}
+
+			var price float64
+			if len(cost.Rows) != 0 {
+				price = cost.Rows[i][j]
+			}
+
+			if _, err := g.AddEdge(i, j, c, price); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+func (g *Network) Nodes() int {
+	return len(g.adj)
+}
+
+// AddEdge adds the arc and returns its id.
+func (g *Network) AddEdge(from, to int, capacity, cost float64) (int, error) {
+	if err := g.validateNode(from); err != nil {
+		return 0, err
+	}
+
+	if err := g.validateNode(to); err != nil {
+		return 0, err
+	}
+
+	if capacity < 0 || math.IsNaN(capacity) || math.IsInf(capacity, 0) {
+		return 0, ErrInvalidCapacity
+	}
+
+	if math.IsNaN(cost) || math.IsInf(cost, 0) {
+		return 0, ErrInvalidCost
+	}
+
+	id := len(g.edges)
+	g.edges = append(g.edges,
+		Edge{From: from, To: to, Capacity: capacity, Cost: cost},
+		Edge{From: to, To: from, Cost: -cost},
+	)
+	g.adj[from] = append(g.adj[from], id)
+	g.adj[to] = append(g.adj[to], id+1)
+	return id, nil
+}
+
+// Edges returns the arcs added by AddEdge with their current flows.
+func (g *Network) Edges() []Edge {
+	res := make([]Edge, 0, len(g.edges)/2)
+	for i := 0; i < len(g.edges); i += 2 {
+		res = append(res, g.edges[i])
+	}
+
+	return res
+}
+
+// Flows sums the flows of the arcs between every pair of nodes.
+func (g *Network) Flows() matrix.Matrix {
+	m := matrix.Matrix{Rows: make([]matrix.Row, g.Nodes())}
+	for i := range m.Rows {
+		m.Rows[i] = make(matrix.Row, g.Nodes())
+	}
+
+	for _, e := range g.Edges() {
+		m.Rows[e.From][e.To] += e.Flow
+	}
+
+	return m
+}
+
+// Reset removes every flow from the network.
+func (g *Network) Reset() {
+	for i := range g.edges {
+		g.edges[i].Flow = 0
+	}
+}
+
+func (g *Network) push(id int, amount float64) {
+	g.edges[id].Flow += amount
+	g.edges[id^1].Flow -= amount
+}
+
+func (g *Network) validateNode(n int) error {
+	if n < 0 || n >= g.Nodes() {
+		return fmt.Errorf("%w: %d", ErrInvalidNode, n)
+	}
+
+	return nil
+}
+
+func (g *Network) validateEnds(source, sink int) error {
+	if err := g.validateNode(source); err != nil {
+		return err
+	}
+
+	if err := g.validateNode(sink); err != nil {
+		return err
+	}
+
+	if source == sink {
+		return ErrSameNodes
+	}
+
+	return nil
+}
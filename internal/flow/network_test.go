@@ -0,0 +1,99 @@
+package flow
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestFromCapacityAndCost(t *testing.T) {
+	tc := []struct {
+		name     string
+		capacity matrix.Matrix
+		cost     matrix.Matrix
+		edges    []Edge
+		err      error
+	}{
+		{
+			name:     "Should skip zeros and diagonal",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{5, 3, 0}, {0, 0, 2}, {1, 0, 0}}},
+			cost:     matrix.Matrix{Rows: []matrix.Row{{0, 4, 0}, {0, 0, -1}, {2, 0, 0}}},
+			edges: []Edge{
+				{From: 0, To: 1, Capacity: 3, Cost: 4},
+				{From: 1, To: 2, Capacity: 2, Cost: -1},
+				{From: 2, To: 0, Capacity: 1, Cost: 2},
+			},
+		},
+		{
+			name:     "Should allow empty cost",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{0, 3}, {0, 0}}},
+			edges:    []Edge{{From: 0, To: 1, Capacity: 3}},
+		},
+		{
+			name:     "Should not allow non square capacity",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{0, 3, 1}, {0, 0, 1}}},
+			err:      ErrNotSquare,
+		},
+		{
+			name:     "Should not allow cost of other size",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{0, 3}, {0, 0}}},
+			cost:     matrix.Matrix{Rows: []matrix.Row{{0}}},
+			err:      ErrNotSquare,
+		},
+		{
+			name:     "Should not allow negative capacity",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{0, -3}, {0, 0}}},
+			err:      ErrInvalidCapacity,
+		},
+		{
+			name:     "Should not allow infinite cost",
+			capacity: matrix.Matrix{Rows: []matrix.Row{{0, 3}, {0, 0}}},
+			cost:     matrix.Matrix{Rows: []matrix.Row{{0, math.Inf(1)}, {0, 0}}},
+			err:      ErrInvalidCost,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g, err := FromCapacityAndCost(tt.capacity, tt.cost)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got := g.Edges(); !reflect.DeepEqual(got, tt.edges) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.edges, got)
+			}
+		})
+	}
+}
+
+func TestAddEdge(t *testing.T) {
+	t.Parallel()
+	g := NewNetwork(2)
+	if _, err := g.AddEdge(0, 2, 1, 0); !errors.Is(err, ErrInvalidNode) {
+		t.Fatalf("Expected to get error: %v, got: %v", ErrInvalidNode, err)
+	}
+
+	first, _ := g.AddEdge(0, 1, 1, 0)
+	second, _ := g.AddEdge(0, 1, 2, 0)
+	g.push(first, 1)
+	g.push(second, 1)
+
+	flows := g.Flows()
+	if flows.Rows[0][1] != 2 || flows.Rows[1][0] != 0 {
+		t.Fatalf("Expected parallel flows to be summed, got: %v", flows.Rows)
+	}
+
+	g.Reset()
+	if flows := g.Flows(); flows.Rows[0][1] != 0 {
+		t.Fatalf("Expected to get no flow after reset, got: %v", flows.Rows)
+	}
+}
@@ -0,0 +1,299 @@
+package games
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	DefaultConvergencePoints = 20
+	DefaultChartHeight       = 10
+)
+
+type ConvergencePoint struct {
+	Step      int
+	Avg       float64
+	Deviation float64
+}
+
+type StrategyFrequency struct {
+	Strategy  int
+	Expected  float64
+	Empirical float64
+	Observed  int
+}
+
+type ChiSquare struct {
+	Statistic        float64
+	DegreesOfFreedom int
+	PValue           float64
+}
+
+type ConvergenceReport struct {
+	Theoretical float64
+	Points      []ConvergencePoint
+
+	FirstPlayerFrequencies  []StrategyFrequency
+	SecondPlayerFrequencies []StrategyFrequency
+
+	FirstPlayerChiSquare  ChiSquare
+	SecondPlayerChiSquare ChiSquare
+}
+
+func BuildConvergenceReport(
+	steps []SimulationStep,
+	first, second Weights,
+	theoretical float64,
+	points int,
+) (*ConvergenceReport, error) {
+	if len(steps) == 0 {
+		return nil, errors.New("cannot build report without simulation steps")
+	}
+
+	if points <= 0 {
+		points = DefaultConvergencePoints
+	}
+
+	firstObserved := make([]int, len(first))
+	secondObserved := make([]int, len(second))
+	for _, s := range steps {
+		fs, ss := s.FirstPlayerStrategy.FirstStageIndex, s.SecondPlayerStrategy.SecondStageIndex
+		if fs >= len(first) || ss >= len(second) {
+			return nil, fmt.Errorf("step strategy (%d,%d) is out of mixed strategy bounds", fs, ss)
+		}
+
+		firstObserved[fs]++
+		secondObserved[ss]++
+	}
+
+	report := ConvergenceReport{
+		Theoretical:             theoretical,
+		Points:                  convergencePoints(steps, theoretical, points),
+		FirstPlayerFrequencies:  strategyFrequencies(firstObserved, first),
+		SecondPlayerFrequencies: strategyFrequencies(secondObserved, second),
+	}
+
+	var err error
+	if report.FirstPlayerChiSquare, err = ChiSquareTest(firstObserved, first); err != nil {
+		return nil, fmt.Errorf("first player: %w", err)
+	}
+
+	if report.SecondPlayerChiSquare, err = ChiSquareTest(secondObserved, second); err != nil {
+		return nil, fmt.Errorf("second player: %w", err)
+	}
+
+	return &report, nil
+}
+
+func convergencePoints(steps []SimulationStep, theoretical float64, n int) []ConvergencePoint {
+	if n > len(steps) {
+		n = len(steps)
+	}
+
+	res := make([]ConvergencePoint, 0, n)
+	for i := 1; i <= n; i++ {
+		idx := i*len(steps)/n - 1
+		avg := steps[idx].FirstPlayerWinAvg
+		res = append(res, ConvergencePoint{
+			Step:      idx + 1,
+			Avg:       avg,
+			Deviation: avg - theoretical,
+		})
+	}
+
+	return res
+}
+
+func strategyFrequencies(observed []int, weights Weights) []StrategyFrequency {
+	var total int
+	for _, o := range observed {
+		total += o
+	}
+
+	expected := normalized(weights)
+	res := make([]StrategyFrequency, len(observed))
+	for i, o := range observed {
+		res[i] = StrategyFrequency{
+			Strategy:  i,
+			Expected:  expected[i],
+			Empirical: float64(o) / float64(total),
+			Observed:  o,
+		}
+	}
+
+	return res
+}
+
+func normalized(weights Weights) Weights {
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+
+	res := make(Weights, len(weights))
+	if sum == 0 {
+		return res
+	}
+
+	for i, w := range weights {
+		res[i] = w / sum
+	}
+
+	return res
+}
+
+// ChiSquareTest checks observed strategy counts against the mixed strategy.
+// Strategies with zero weight are never played, so they are excluded from
+// the test.
+func ChiSquareTest(observed []int, weights Weights) (ChiSquare, error) {
+	if len(observed) != len(weights) {
+		return ChiSquare{}, errors.New("observed counts should be same length as weights")
+	}
+
+	var total int
+	for _, o := range observed {
+		total += o
+	}
+
+	if total == 0 {
+		return ChiSquare{}, errors.New("cannot run chi-square test without observations")
+	}
+
+	var (
+		res        ChiSquare
+		categories int
+	)
+
+	for i, p := range normalized(weights) {
+		if p == 0 {
+			continue
+		}
+
+		expected := p * float64(total)
+		diff := float64(observed[i]) - expected
+		res.Statistic += diff * diff / expected
+		categories++
+	}
+
+	if categories < 2 {
+		res.PValue = 1
+		return res, nil
+	}
+
+	res.DegreesOfFreedom = categories - 1
+	res.PValue = upperIncompleteGammaRatio(float64(res.DegreesOfFreedom)/2, res.Statistic/2)
+	return res, nil
+}
+
+func upperIncompleteGammaRatio(a, x float64) float64 {
+	const (
+		eps   = 1e-14
+		iters = 500
+		tiny  = 1e-300
+	)
+
+	if x <= 0 {
+		return 1
+	}
+
+	lg, _ := math.Lgamma(a)
+	front := math.Exp(-x + a*math.Log(x) - lg)
+
+	if x < a+1 {
+		sum := 1 / a
+		del := sum
+		for n := 1; n < iters; n++ {
+			del *= x / (a + float64(n))
+			sum += del
+			if math.Abs(del) < math.Abs(sum)*eps {
+				break
+			}
+		}
+		return math.Max(0, 1-sum*front)
+	}
+
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for n := 1; n < iters; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+
+	return front * h
+}
+
+// RenderConvergenceChart draws the running average as '*' and the
+// theoretical game weight as '-'. Points on the theoretical line are '#'.
+func RenderConvergenceChart(r ConvergenceReport, height int) string {
+	if len(r.Points) == 0 {
+		return ""
+	}
+
+	if height < 2 {
+		height = DefaultChartHeight
+	}
+
+	lo, hi := r.Theoretical, r.Theoretical
+	for _, p := range r.Points {
+		lo = math.Min(lo, p.Avg)
+		hi = math.Max(hi, p.Avg)
+	}
+
+	if hi == lo {
+		hi, lo = hi+1, lo-1
+	}
+
+	level := func(v float64) int {
+		return int(math.Round((v - lo) / (hi - lo) * float64(height-1)))
+	}
+
+	grid := make([][]byte, height)
+	for i := range grid {
+		grid[i] = []byte(strings.Repeat(" ", len(r.Points)))
+	}
+
+	theoretical := level(r.Theoretical)
+	for col, p := range r.Points {
+		grid[theoretical][col] = '-'
+		if row := level(p.Avg); row == theoretical {
+			grid[row][col] = '#'
+		} else {
+			grid[row][col] = '*'
+		}
+	}
+
+	var b strings.Builder
+	for row := height - 1; row >= 0; row-- {
+		label := lo + (hi-lo)*float64(row)/float64(height-1)
+		fmt.Fprintf(&b, "%10.2f | %s\n", label, grid[row])
+	}
+
+	fmt.Fprintf(&b, "%10s +-%s\n", "", strings.Repeat("-", len(r.Points)))
+	fmt.Fprintf(
+		&b,
+		"%10s   steps %d..%d\n",
+		"",
+		r.Points[0].Step,
+		r.Points[len(r.Points)-1].Step,
+	)
+
+	return b.String()
+}
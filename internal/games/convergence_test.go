@@ -0,0 +1,127 @@
+package games
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestChiSquareTest(t *testing.T) {
+	tc := []struct {
+		name     string
+		observed []int
+		weights  Weights
+		expected ChiSquare
+		wantErr  bool
+	}{
+		{
+			name:     "Should find perfect fit correctly",
+			observed: []int{50, 50},
+			weights:  Weights{0.5, 0.5},
+			expected: ChiSquare{Statistic: 0, DegreesOfFreedom: 1, PValue: 1},
+		},
+		{
+			name:     "Should find chi-square correctly",
+			observed: []int{60, 40},
+			weights:  Weights{0.5, 0.5},
+			expected: ChiSquare{Statistic: 4, DegreesOfFreedom: 1, PValue: 0.0455},
+		},
+		{
+			name:     "Should skip zero weights",
+			observed: []int{30, 0, 70},
+			weights:  Weights{0.3, 0, 0.7},
+			expected: ChiSquare{Statistic: 0, DegreesOfFreedom: 1, PValue: 1},
+		},
+		{
+			name:     "Should find chi-square with several degrees of freedom correctly",
+			observed: []int{20, 30, 50},
+			weights:  Weights{0.25, 0.25, 0.5},
+			expected: ChiSquare{Statistic: 2, DegreesOfFreedom: 2, PValue: 0.3679},
+		},
+		{
+			name:     "Should fail without observations",
+			observed: []int{0, 0},
+			weights:  Weights{0.5, 0.5},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ChiSquareTest(tt.observed, tt.weights)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if math.Abs(got.Statistic-tt.expected.Statistic) > 1e-9 ||
+				got.DegreesOfFreedom != tt.expected.DegreesOfFreedom ||
+				math.Abs(got.PValue-tt.expected.PValue) > 1e-4 {
+				t.Fatalf("Expected to get: %+v, got: %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBuildConvergenceReport(t *testing.T) {
+	first, second := Weights{0.5, 0.5}, Weights{0.5, 0.5}
+	steps, err := SimulateGame(context.Background(), SimulationOptions{
+		Matrix: matrix.Matrix{
+			Rows: []matrix.Row{
+				{1, -1},
+				{-1, 1},
+			},
+		},
+		FirstPlayerStrategy:  first,
+		SecondPlayerStrategy: second,
+		Times:                2000,
+		Seed:                 seed(3),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := BuildConvergenceReport(steps, first, second, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Points) != 10 {
+		t.Fatalf("Expected to get: %d points, got: %d", 10, len(report.Points))
+	}
+
+	if last := report.Points[len(report.Points)-1]; last.Step != len(steps) {
+		t.Fatalf("Expected last point to be at step: %d, got: %d", len(steps), last.Step)
+	}
+
+	var observed int
+	for _, f := range report.FirstPlayerFrequencies {
+		observed += f.Observed
+	}
+
+	if observed != len(steps) {
+		t.Fatalf("Expected to observe: %d strategies, got: %d", len(steps), observed)
+	}
+
+	if report.FirstPlayerChiSquare.PValue < 0.001 {
+		t.Fatalf("Expected fair strategies to pass chi-square test, got: %+v", report.FirstPlayerChiSquare)
+	}
+
+	chart := RenderConvergenceChart(*report, 8)
+	if lines := strings.Count(chart, "\n"); lines != 10 {
+		t.Fatalf("Expected chart to have: %d lines, got: %d\n%s", 10, lines, chart)
+	}
+
+	if !strings.ContainsAny(chart, "-#") {
+		t.Fatalf("Expected chart to contain theoretical line:\n%s", chart)
+	}
+}
+
+func TestBuildConvergenceReportWithoutSteps(t *testing.T) {
+	if _, err := BuildConvergenceReport(nil, Weights{1}, Weights{1}, 0, 10); err == nil {
+		t.Fatal("Expected to get error for empty steps")
+	}
+}
@@ -1,57 +1,313 @@
 package games
 
 import (
-	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strings"
 
 	"github.com/hrvadl/algo/internal/matrix"
 	"github.com/hrvadl/algo/pkg/sliceh"
 )
 
+const (
+	WaldCriterion          = "Wald"
+	MaxMaxCriterion        = "Maxmax"
+	HurwiczCriterion       = "Hurwicz"
+	SavageCriterion        = "Savage"
+	BayesCriterion         = "Bayes"
+	LaplasCriterion        = "Laplas"
+	HodgesLehmannCriterion = "Hodges-Lehmann"
+	ProductCriterion       = "Product"
+	GermeierCriterion      = "Germeier"
+	BayesRegretCriterion   = "Bayes regret"
+)
+
+const productCriterionPadding = 1
+
+type CriterionScores struct {
+	Name     string
+	Scores   matrix.Row
+	Best     []int
+	Minimize bool
+}
+
+type CriteriaOptions struct {
+	Y      float64
+	Lambda float64
+	P      matrix.Row
+}
+
+type CriteriaComparison struct {
+	Criteria []CriterionScores
+}
+
 func SolveWithMaxMinStrategy(m matrix.Matrix) []int {
-	return sliceh.MaxIdxs(sliceh.MinFor2D(m.Rows))
+	return sliceh.MaxIdxs(MaxMinScores(m))
+}
+
+func MaxMinScores(m matrix.Matrix) matrix.Row {
+	return sliceh.MinFor2D(m.Rows)
 }
 
 func SolveWithMaxMaxStrategy(m matrix.Matrix) []int {
-	return sliceh.MaxIdxs(sliceh.MaxFor2D(m.Rows))
+	return sliceh.MaxIdxs(MaxMaxScores(m))
+}
+
+func MaxMaxScores(m matrix.Matrix) matrix.Row {
+	return sliceh.MaxFor2D(m.Rows)
 }
 
 func SolveWithPessimismOptimismStrategy(m matrix.Matrix, y float64) []int {
+	return sliceh.MaxIdxs(PessimismOptimismScores(m, y))
+}
+
+func PessimismOptimismScores(m matrix.Matrix, y float64) matrix.Row {
 	mins := sliceh.MinFor2D(m.Rows)
 	maxs := sliceh.MaxFor2D(m.Rows)
 	res := make(matrix.Row, len(maxs))
 	for i, min := range mins {
 		res[i] = y*min + (1-y)*maxs[i]
 	}
-	return sliceh.MaxIdxs(res)
+	return res
 }
 
 func SolveWithMinMaxStrategy(m matrix.Matrix) []int {
+	return sliceh.MinIdxs(MinMaxScores(m))
+}
+
+func MinMaxScores(m matrix.Matrix) matrix.Row {
 	r := m.NewRisk()
-	return sliceh.MinIdxs(sliceh.MaxFor2D(r.Rows))
+	return sliceh.MaxFor2D(r.Rows)
 }
 
-func SolveWithLaplasPrinciple(m matrix.Matrix, v float64) ([]int, error) {
-	if len(m.Rows) == 0 {
-		return nil, errors.New("p slice should be same length as matrix row")
+func SolveWithLaplasPrinciple(m matrix.Matrix) ([]int, error) {
+	scores, err := LaplasScores(m)
+	if err != nil {
+		return nil, err
 	}
 
-	p := make([]float64, 0, len(m.Rows))
-	for range m.Rows[0] {
-		p = append(p, v)
+	return sliceh.MaxIdxs(scores), nil
+}
+
+func LaplasScores(m matrix.Matrix) (matrix.Row, error) {
+	if len(m.Rows) == 0 || len(m.Rows[0]) == 0 {
+		return nil, ErrEmptyMatrix
 	}
 
-	return SolveWithBayesPrinicple(m, p)
+	return BayesScores(m, UniformProbabilities(len(m.Rows[0])))
 }
 
 func SolveWithBayesPrinicple(m matrix.Matrix, p matrix.Row) ([]int, error) {
+	scores, err := BayesScores(m, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return sliceh.MaxIdxs(scores), nil
+}
+
+func BayesScores(m matrix.Matrix, p matrix.Row) (matrix.Row, error) {
+	if err := validateNatureInput(m, p); err != nil {
+		return nil, err
+	}
+
 	multiplied, err := m.MultiplyByVector(p)
 	if err != nil {
 		return nil, err
 	}
 
-	return sliceh.MaxIdxs(multiplied.SumRows()), nil
+	return multiplied.SumRows(), nil
+}
+
+func SolveWithHodgesLehmannPrinciple(m matrix.Matrix, p matrix.Row, lambda float64) ([]int, error) {
+	scores, err := HodgesLehmannScores(m, p, lambda)
+	if err != nil {
+		return nil, err
+	}
+
+	return sliceh.MaxIdxs(scores), nil
+}
+
+func HodgesLehmannScores(m matrix.Matrix, p matrix.Row, lambda float64) (matrix.Row, error) {
+	if err := ValidateCoefficient(lambda); err != nil {
+		return nil, fmt.Errorf("lambda: %w", err)
+	}
+
+	bayes, err := BayesScores(m, p)
+	if err != nil {
+		return nil, err
+	}
+
+	mins := MaxMinScores(m)
+	res := make(matrix.Row, len(bayes))
+	for i := range bayes {
+		res[i] = lambda*bayes[i] + (1-lambda)*mins[i]
+	}
+
+	return res, nil
+}
+
+func SolveWithProductPrinciple(m matrix.Matrix) []int {
+	return sliceh.MaxIdxs(ProductScores(m))
+}
+
+// ProductScores shifts the matrix so every element is positive before
+// multiplying, since the product criterion is defined for positive gains.
+func ProductScores(m matrix.Matrix) matrix.Row {
+	var shift float64
+	if min := minOf(m); min <= 0 {
+		shift = productCriterionPadding - min
+	}
+
+	res := make(matrix.Row, len(m.Rows))
+	for i, row := range m.Rows {
+		res[i] = 1
+		for _, el := range row {
+			res[i] *= el + shift
+		}
+	}
+
+	return res
+}
+
+func SolveWithGermeierPrinciple(m matrix.Matrix, p matrix.Row) ([]int, error) {
+	scores, err := GermeierScores(m, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return sliceh.MaxIdxs(scores), nil
+}
+
+// GermeierScores shifts the matrix so every element is negative, since
+// the Germeier criterion is defined for losses.
+func GermeierScores(m matrix.Matrix, p matrix.Row) (matrix.Row, error) {
+	if err := validateNatureInput(m, p); err != nil {
+		return nil, err
+	}
+
+	var shift float64
+	if max := maxOf(m); max >= 0 {
+		shift = -max - 1
+	}
+
+	shifted := m.Add(shift)
+	multiplied, err := shifted.MultiplyByVector(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return sliceh.MinFor2D(multiplied.Rows), nil
+}
+
+func SolveWithBayesRegretPrinciple(m matrix.Matrix, p matrix.Row) ([]int, error) {
+	scores, err := BayesRegretScores(m, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return sliceh.MinIdxs(scores), nil
+}
+
+func BayesRegretScores(m matrix.Matrix, p matrix.Row) (matrix.Row, error) {
+	if err := validateNatureInput(m, p); err != nil {
+		return nil, err
+	}
+
+	r := m.NewRisk()
+	multiplied, err := r.MultiplyByVector(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return multiplied.SumRows(), nil
+}
+
+func CompareCriteria(m matrix.Matrix, opt CriteriaOptions) (*CriteriaComparison, error) {
+	if err := ValidateCoefficient(opt.Y); err != nil {
+		return nil, fmt.Errorf("hurwicz: %w", err)
+	}
+
+	bayes, err := BayesScores(m, opt.P)
+	if err != nil {
+		return nil, fmt.Errorf("bayes: %w", err)
+	}
+
+	hodgesLehmann, err := HodgesLehmannScores(m, opt.P, opt.Lambda)
+	if err != nil {
+		return nil, fmt.Errorf("hodges-lehmann: %w", err)
+	}
+
+	germeier, err := GermeierScores(m, opt.P)
+	if err != nil {
+		return nil, fmt.Errorf("germeier: %w", err)
+	}
+
+	regret, err := BayesRegretScores(m, opt.P)
+	if err != nil {
+		return nil, fmt.Errorf("bayes regret: %w", err)
+	}
+
+	laplas, err := LaplasScores(m)
+	if err != nil {
+		return nil, fmt.Errorf("laplas: %w", err)
+	}
+
+	return &CriteriaComparison{
+		Criteria: []CriterionScores{
+			newCriterionScores(WaldCriterion, MaxMinScores(m), false),
+			newCriterionScores(MaxMaxCriterion, MaxMaxScores(m), false),
+			newCriterionScores(HurwiczCriterion, PessimismOptimismScores(m, opt.Y), false),
+			newCriterionScores(SavageCriterion, MinMaxScores(m), true),
+			newCriterionScores(BayesCriterion, bayes, false),
+			newCriterionScores(LaplasCriterion, laplas, false),
+			newCriterionScores(HodgesLehmannCriterion, hodgesLehmann, false),
+			newCriterionScores(ProductCriterion, ProductScores(m), false),
+			newCriterionScores(GermeierCriterion, germeier, false),
+			newCriterionScores(BayesRegretCriterion, regret, true),
+		},
+	}, nil
+}
+
+func newCriterionScores(name string, scores matrix.Row, minimize bool) CriterionScores {
+	best := sliceh.MaxIdxs(scores)
+	if minimize {
+		best = sliceh.MinIdxs(scores)
+	}
+
+	return CriterionScores{
+		Name:     name,
+		Scores:   scores,
+		Best:     best,
+		Minimize: minimize,
+	}
+}
+
+func (c CriterionScores) IsBest(strategy int) bool {
+	return slices.Contains(c.Best, strategy)
+}
+
+func minOf(m matrix.Matrix) float64 {
+	min := math.Inf(1)
+	for _, row := range m.Rows {
+		if len(row) == 0 {
+			continue
+		}
+		min = math.Min(min, slices.Min(row))
+	}
+	return min
+}
+
+func maxOf(m matrix.Matrix) float64 {
+	max := math.Inf(-1)
+	for _, row := range m.Rows {
+		if len(row) == 0 {
+			continue
+		}
+		max = math.Max(max, slices.Max(row))
+	}
+	return max
 }
 
 func ToHumanReadable(s []int) string {
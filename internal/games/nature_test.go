@@ -1,6 +1,7 @@
 package games
 
 import (
+	"errors"
 	"reflect"
 	"slices"
 	"testing"
@@ -207,11 +208,11 @@ func TestSolveWithLaplasPrinciple(t *testing.T) {
 	tc := []struct {
 		name     string
 		m        matrix.Matrix
-		v        float64
 		expected []int
+		wantErr  error
 	}{
 		{
-			name: "Should solve with bayes principle correctly",
+			name: "Should solve with laplas principle correctly",
 			m: matrix.Matrix{
 				Rows: []matrix.Row{
 					{-1, 1, 1, 4},
@@ -219,17 +220,241 @@ func TestSolveWithLaplasPrinciple(t *testing.T) {
 					{3, -1, 3, 2},
 				},
 			},
-			v:        1. / 4,
 			expected: []int{2},
 		},
+		{
+			name:    "Should fail on empty matrix",
+			m:       matrix.Matrix{},
+			wantErr: ErrEmptyMatrix,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SolveWithLaplasPrinciple(tt.m)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if !slices.Equal(tt.expected, got) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSolveWithHodgesLehmannPrinciple(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        matrix.Matrix
+		p        matrix.Row
+		lambda   float64
+		expected []int
+		wantErr  bool
+	}{
+		{
+			name: "Should solve with hodges-lehmann principle correctly",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{-1, 1, 1, 4},
+					{-1, -2, 2, 3},
+					{3, -1, 3, 2},
+				},
+			},
+			p:        matrix.Row{0.2, 0.4, 0.1, 0.3},
+			lambda:   0.5,
+			expected: []int{0},
+		},
+		{
+			name: "Should act as wald principle when lambda is zero",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{-2, 1, 1, 4},
+					{-1, -2, 2, 3},
+					{3, -1, 3, 2},
+				},
+			},
+			p:        matrix.Row{0.2, 0.4, 0.1, 0.3},
+			lambda:   0,
+			expected: []int{2},
+		},
+		{
+			name: "Should fail with lambda out of range",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{-1, 1},
+				},
+			},
+			p:       matrix.Row{0.5, 0.5},
+			lambda:  2,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SolveWithHodgesLehmannPrinciple(tt.m, tt.p, tt.lambda)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if !slices.Equal(tt.expected, got) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSolveWithProductPrinciple(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        matrix.Matrix
+		expected []int
+	}{
+		{
+			name: "Should solve with product principle correctly",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{-1, 1, 1, 4},
+					{-1, -2, 2, 3},
+					{3, -1, 3, 2},
+				},
+			},
+			expected: []int{2},
+		},
+		{
+			name: "Should solve with product principle for positive matrix correctly",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{1, 9},
+					{3, 4},
+				},
+			},
+			expected: []int{1},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := SolveWithProductPrinciple(tt.m); !slices.Equal(tt.expected, got) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSolveWithGermeierPrinciple(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        matrix.Matrix
+		p        matrix.Row
+		expected []int
+	}{
+		{
+			name: "Should solve with germeier principle correctly",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{-1, 1, 1, 4},
+					{-1, -2, 2, 3},
+					{3, -1, 3, 2},
+				},
+			},
+			p:        matrix.Row{0.2, 0.4, 0.1, 0.3},
+			expected: []int{0},
+		},
 	}
 
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			if got, _ := SolveWithLaplasPrinciple(tt.m, tt.v); !slices.Equal(tt.expected, got) {
+			if got, _ := SolveWithGermeierPrinciple(tt.m, tt.p); !slices.Equal(tt.expected, got) {
 				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
 			}
 		})
 	}
 }
+
+func TestSolveWithBayesRegretPrinciple(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        matrix.Matrix
+		p        matrix.Row
+		expected []int
+	}{
+		{
+			name: "Should solve with bayes regret principle correctly",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{-1, 1, 1, 4},
+					{-1, -2, 2, 3},
+					{3, -1, 3, 2},
+				},
+			},
+			p:        matrix.Row{0.2, 0.4, 0.1, 0.3},
+			expected: []int{0},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got, _ := SolveWithBayesRegretPrinciple(tt.m, tt.p); !slices.Equal(tt.expected, got) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompareCriteria(t *testing.T) {
+	m := matrix.Matrix{
+		Rows: []matrix.Row{
+			{-1, 1, 1, 4},
+			{-1, -2, 2, 3},
+			{3, -1, 3, 2},
+		},
+	}
+
+	got, err := CompareCriteria(m, CriteriaOptions{
+		Y:      0.3,
+		Lambda: 0.5,
+		P:      matrix.Row{0.2, 0.4, 0.1, 0.3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]struct {
+		scores matrix.Row
+		best   []int
+	}{
+		WaldCriterion:          {scores: matrix.Row{-1, -2, -1}, best: []int{0, 2}},
+		SavageCriterion:        {scores: matrix.Row{4, 4, 2}, best: []int{2}},
+		BayesCriterion:         {scores: matrix.Row{1.5, 0.1, 1.1}, best: []int{0}},
+		HodgesLehmannCriterion: {scores: matrix.Row{0.25, -0.95, 0.05}, best: []int{0}},
+		ProductCriterion:       {scores: matrix.Row{224, 60, 360}, best: []int{2}},
+		GermeierCriterion:      {scores: matrix.Row{-1.6, -2.8, -2.4}, best: []int{0}},
+		BayesRegretCriterion:   {scores: matrix.Row{1, 2.4, 1.4}, best: []int{0}},
+	}
+
+	for _, c := range got.Criteria {
+		want, ok := expected[c.Name]
+		if !ok {
+			continue
+		}
+
+		if scores := matrix.RoundRowTo(c.Scores, 2); !slices.Equal(scores, want.scores) {
+			t.Errorf("%s: expected scores: %v, got: %v", c.Name, want.scores, scores)
+		}
+
+		if !slices.Equal(c.Best, want.best) {
+			t.Errorf("%s: expected best: %v, got: %v", c.Name, want.best, c.Best)
+		}
+	}
+
+	if len(got.Criteria) != 10 {
+		t.Fatalf("Expected to get: %d criteria, got: %d", 10, len(got.Criteria))
+	}
+}
@@ -0,0 +1,113 @@
+package games
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const probabilitySumEpsilon = 1e-6
+
+var (
+	ErrEmptyMatrix           = errors.New("matrix is empty")
+	ErrProbabilityLength     = errors.New("p slice should be same length as matrix row")
+	ErrNegativeProbability   = errors.New("probability should not be negative")
+	ErrInvalidProbability    = errors.New("probability should be a finite number")
+	ErrProbabilitySum        = errors.New("probabilities should sum up to 1")
+	ErrCoefficientOutOfRange = errors.New("coefficient should be in range [0;1]")
+)
+
+type ProbabilityError struct {
+	Index int
+	Value float64
+	Err   error
+}
+
+func (e *ProbabilityError) Error() string {
+	return fmt.Sprintf("p[%d] = %v: %v", e.Index, e.Value, e.Err)
+}
+
+func (e *ProbabilityError) Unwrap() error {
+	return e.Err
+}
+
+func ValidateProbabilities(p matrix.Row, n int) error {
+	if len(p) != n {
+		return fmt.Errorf("%w: expected %d, got %d", ErrProbabilityLength, n, len(p))
+	}
+
+	if err := validateProbabilityValues(p); err != nil {
+		return err
+	}
+
+	if sum := sumOf(p); math.Abs(sum-1) > probabilitySumEpsilon {
+		return fmt.Errorf("%w: got %v", ErrProbabilitySum, sum)
+	}
+
+	return nil
+}
+
+func NormalizeProbabilities(p matrix.Row) (matrix.Row, error) {
+	if err := validateProbabilityValues(p); err != nil {
+		return nil, err
+	}
+
+	sum := sumOf(p)
+	if sum == 0 {
+		return nil, fmt.Errorf("%w: cannot normalize zero vector", ErrProbabilitySum)
+	}
+
+	res := make(matrix.Row, len(p))
+	for i, el := range p {
+		res[i] = el / sum
+	}
+
+	return res, nil
+}
+
+func UniformProbabilities(n int) matrix.Row {
+	res := make(matrix.Row, n)
+	for i := range res {
+		res[i] = 1 / float64(n)
+	}
+	return res
+}
+
+func ValidateCoefficient(c float64) error {
+	if math.IsNaN(c) || c < 0 || c > 1 {
+		return fmt.Errorf("%w: got %v", ErrCoefficientOutOfRange, c)
+	}
+	return nil
+}
+
+func validateNatureInput(m matrix.Matrix, p matrix.Row) error {
+	if len(m.Rows) == 0 || len(m.Rows[0]) == 0 {
+		return ErrEmptyMatrix
+	}
+
+	return ValidateProbabilities(p, len(m.Rows[0]))
+}
+
+func validateProbabilityValues(p matrix.Row) error {
+	for i, el := range p {
+		if math.IsNaN(el) || math.IsInf(el, 0) {
+			return &ProbabilityError{Index: i, Value: el, Err: ErrInvalidProbability}
+		}
+
+		if el < 0 {
+			return &ProbabilityError{Index: i, Value: el, Err: ErrNegativeProbability}
+		}
+	}
+
+	return nil
+}
+
+func sumOf(p matrix.Row) float64 {
+	var sum float64
+	for _, el := range p {
+		sum += el
+	}
+	return sum
+}
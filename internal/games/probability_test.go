@@ -0,0 +1,116 @@
+package games
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestValidateProbabilities(t *testing.T) {
+	tc := []struct {
+		name    string
+		p       matrix.Row
+		n       int
+		wantErr error
+	}{
+		{
+			name: "Should accept valid distribution",
+			p:    matrix.Row{0.2, 0.4, 0.1, 0.3},
+			n:    4,
+		},
+		{
+			name:    "Should reject distribution with wrong length",
+			p:       matrix.Row{0.5, 0.5},
+			n:       3,
+			wantErr: ErrProbabilityLength,
+		},
+		{
+			name:    "Should reject negative probability",
+			p:       matrix.Row{1.5, -0.5},
+			n:       2,
+			wantErr: ErrNegativeProbability,
+		},
+		{
+			name:    "Should reject distribution which does not sum up to 1",
+			p:       matrix.Row{0.5, 0.6},
+			n:       2,
+			wantErr: ErrProbabilitySum,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := ValidateProbabilities(tt.p, tt.n); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateProbabilitiesReturnsIndex(t *testing.T) {
+	err := ValidateProbabilities(matrix.Row{0.5, 0.7, -0.2}, 3)
+
+	var perr *ProbabilityError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Expected to get probability error, got: %v", err)
+	}
+
+	if perr.Index != 2 {
+		t.Fatalf("Expected to get: %d, got: %d", 2, perr.Index)
+	}
+}
+
+func TestNormalizeProbabilities(t *testing.T) {
+	tc := []struct {
+		name     string
+		p        matrix.Row
+		expected matrix.Row
+		wantErr  error
+	}{
+		{
+			name:     "Should normalize weights correctly",
+			p:        matrix.Row{1, 3},
+			expected: matrix.Row{0.25, 0.75},
+		},
+		{
+			name:    "Should reject zero vector",
+			p:       matrix.Row{0, 0},
+			wantErr: ErrProbabilitySum,
+		},
+		{
+			name:    "Should reject negative weights",
+			p:       matrix.Row{1, -1},
+			wantErr: ErrNegativeProbability,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NormalizeProbabilities(tt.p)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if !slices.Equal(got, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSolveWithBayesPrincipleRejectsInvalidDistribution(t *testing.T) {
+	m := matrix.Matrix{
+		Rows: []matrix.Row{
+			{-1, 1},
+			{3, -1},
+		},
+	}
+
+	if _, err := SolveWithBayesPrinicple(m, matrix.Row{2, 2}); !errors.Is(err, ErrProbabilitySum) {
+		t.Fatalf("Expected to get: %v, got: %v", ErrProbabilitySum, err)
+	}
+}
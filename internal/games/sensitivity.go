@@ -0,0 +1,123 @@
+package games
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const sensitivityEpsilon = 1e-9
+
+type DecisionInterval struct {
+	From       float64
+	To         float64
+	Strategies []int
+}
+
+func (d DecisionInterval) String() string {
+	return fmt.Sprintf(
+		"[%v; %v]: %s",
+		matrix.RoundTo(d.From, 4),
+		matrix.RoundTo(d.To, 4),
+		strings.TrimSpace(ToHumanReadable(d.Strategies)),
+	)
+}
+
+// HurwiczIntervals splits y in [0;1] into intervals where the
+// pessimism-optimism strategy chooses the same alternatives.
+func HurwiczIntervals(m matrix.Matrix) ([]DecisionInterval, error) {
+	if len(m.Rows) == 0 {
+		return nil, ErrEmptyMatrix
+	}
+
+	mins := MaxMinScores(m)
+	maxs := MaxMaxScores(m)
+	slopes := make(matrix.Row, len(mins))
+	for i := range mins {
+		slopes[i] = mins[i] - maxs[i]
+	}
+
+	return linearDecisionIntervals(maxs, slopes), nil
+}
+
+// BayesIntervals moves the probability vector from p0 to p1 along the line
+// (1-t)*p0 + t*p1 and splits t in [0;1] into intervals where the Bayes
+// strategy chooses the same alternatives.
+func BayesIntervals(m matrix.Matrix, p0, p1 matrix.Row) ([]DecisionInterval, error) {
+	from, err := BayesScores(m, p0)
+	if err != nil {
+		return nil, fmt.Errorf("start distribution: %w", err)
+	}
+
+	to, err := BayesScores(m, p1)
+	if err != nil {
+		return nil, fmt.Errorf("end distribution: %w", err)
+	}
+
+	slopes := make(matrix.Row, len(from))
+	for i := range from {
+		slopes[i] = to[i] - from[i]
+	}
+
+	return linearDecisionIntervals(from, slopes), nil
+}
+
+func linearDecisionIntervals(intercepts, slopes matrix.Row) []DecisionInterval {
+	breakpoints := []float64{0, 1}
+	for i := range intercepts {
+		for j := i + 1; j < len(intercepts); j++ {
+			ds := slopes[i] - slopes[j]
+			if math.Abs(ds) < sensitivityEpsilon {
+				continue
+			}
+
+			t := (intercepts[j] - intercepts[i]) / ds
+			if t > 0 && t < 1 {
+				breakpoints = append(breakpoints, t)
+			}
+		}
+	}
+
+	slices.Sort(breakpoints)
+	breakpoints = slices.CompactFunc(breakpoints, func(a, b float64) bool {
+		return math.Abs(a-b) < sensitivityEpsilon
+	})
+
+	res := make([]DecisionInterval, 0, len(breakpoints))
+	for i := 0; i < len(breakpoints)-1; i++ {
+		from, to := breakpoints[i], breakpoints[i+1]
+		best := bestAt(intercepts, slopes, (from+to)/2)
+
+		if last := len(res) - 1; last >= 0 && slices.Equal(res[last].Strategies, best) {
+			res[last].To = to
+			continue
+		}
+
+		res = append(res, DecisionInterval{
+			From:       from,
+			To:         to,
+			Strategies: best,
+		})
+	}
+
+	return res
+}
+
+func bestAt(intercepts, slopes matrix.Row, t float64) []int {
+	best := math.Inf(-1)
+	for i := range intercepts {
+		best = math.Max(best, intercepts[i]+t*slopes[i])
+	}
+
+	res := make([]int, 0, 1)
+	for i := range intercepts {
+		if best-(intercepts[i]+t*slopes[i]) < sensitivityEpsilon {
+			res = append(res, i)
+		}
+	}
+
+	return res
+}
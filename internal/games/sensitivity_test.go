@@ -0,0 +1,131 @@
+package games
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestHurwiczIntervals(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        matrix.Matrix
+		expected []DecisionInterval
+	}{
+		{
+			name: "Should find hurwicz intervals correctly",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{0, 10},
+					{4, 5},
+					{-1, 3},
+				},
+			},
+			expected: []DecisionInterval{
+				{From: 0, To: 5. / 9, Strategies: []int{0}},
+				{From: 5. / 9, To: 1, Strategies: []int{1}},
+			},
+		},
+		{
+			name: "Should merge intervals with the same strategy",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{5, 6},
+					{1, 2},
+				},
+			},
+			expected: []DecisionInterval{
+				{From: 0, To: 1, Strategies: []int{0}},
+			},
+		},
+		{
+			name: "Should keep ties between equal strategies",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{1, 2},
+					{2, 1},
+				},
+			},
+			expected: []DecisionInterval{
+				{From: 0, To: 1, Strategies: []int{0, 1}},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := HurwiczIntervals(tt.m)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !equalIntervals(got, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBayesIntervals(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        matrix.Matrix
+		p0       matrix.Row
+		p1       matrix.Row
+		expected []DecisionInterval
+		wantErr  bool
+	}{
+		{
+			name: "Should find bayes intervals correctly",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{4, 0},
+					{0, 4},
+					{1.5, 1.5},
+				},
+			},
+			p0: matrix.Row{1, 0},
+			p1: matrix.Row{0, 1},
+			expected: []DecisionInterval{
+				{From: 0, To: 0.5, Strategies: []int{0}},
+				{From: 0.5, To: 1, Strategies: []int{1}},
+			},
+		},
+		{
+			name: "Should fail on distribution with wrong length",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{4, 0},
+				},
+			},
+			p0:      matrix.Row{1},
+			p1:      matrix.Row{0, 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := BayesIntervals(tt.m, tt.p0, tt.p1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if !equalIntervals(got, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func equalIntervals(a, b []DecisionInterval) bool {
+	return slices.EqualFunc(a, b, func(x, y DecisionInterval) bool {
+		return math.Abs(x.From-y.From) < 1e-9 &&
+			math.Abs(x.To-y.To) < 1e-9 &&
+			slices.Equal(x.Strategies, y.Strategies)
+	})
+}
@@ -1,11 +1,27 @@
 package games
 
 import (
+	"context"
+	"errors"
+	"math"
 	"math/rand"
+	"sync"
+	"time"
 
 	"github.com/hrvadl/algo/internal/matrix"
 )
 
+// ConfidenceZ is the z-score used for the 95% confidence interval
+// of the average first player win.
+const ConfidenceZ = 1.96
+
+// cancelCheckEvery is the amount of rounds played between context checks.
+const cancelCheckEvery = 1024
+
+// shardSize is the amount of rounds played with one seed. It doesn't
+// depend on the workers, so a seed gives the same rounds on any machine.
+const shardSize = 4096
+
 type (
 	Weight  = float64
 	Weights = []Weight
@@ -23,26 +39,201 @@ type SimulationStep struct {
 	FirstPlayerWinAvg float64
 }
 
+type SimulationSummary struct {
+	Times          int
+	Mean           float64
+	Variance       float64
+	StdErr         float64
+	ConfidenceLow  float64
+	ConfidenceHigh float64
+}
+
 type SimulationOptions struct {
 	Matrix               matrix.Matrix
 	FirstPlayerStrategy  Weights
 	SecondPlayerStrategy Weights
 	Times                int
+
+	// Source takes precedence over Seed. When both are nil the
+	// simulation is seeded with the current time.
+	Source rand.Source
+	Seed   *int64
+
+	// Workers is the amount of goroutines playing the shards of the
+	// simulation. Zero or one means it runs on the calling goroutine.
+	Workers int
+}
+
+type shard struct {
+	times     int
+	rnd       *rand.Rand
+	steps     []SimulationStep
+	sum       float64
+	sumSq     float64
+	keepSteps bool
 }
 
-func SimulateGame(opt SimulationOptions) []SimulationStep {
+func SimulateGame(ctx context.Context, opt SimulationOptions) ([]SimulationStep, error) {
+	shards, err := runShards(ctx, opt, true)
+	if err != nil {
+		return nil, err
+	}
+
 	total := make([]SimulationStep, 0, opt.Times)
-	var totalWin float64
 
-	for i := range opt.Times {
-		fn := rand.Float64()
-		sn := rand.Float64()
+	var offset float64
+	for _, s := range shards {
+		for _, step := range s.steps {
+			step.FirstPlayerWinSum += offset
+			step.FirstPlayerWinAvg = step.FirstPlayerWinSum / float64(len(total)+1)
+			total = append(total, step)
+		}
+		offset += s.sum
+	}
+
+	return total, nil
+}
+
+func SummarizeGame(ctx context.Context, opt SimulationOptions) (SimulationSummary, error) {
+	shards, err := runShards(ctx, opt, false)
+	if err != nil {
+		return SimulationSummary{}, err
+	}
+
+	var sum, sumSq float64
+	for _, s := range shards {
+		sum += s.sum
+		sumSq += s.sumSq
+	}
+
+	return summarize(opt.Times, sum, sumSq), nil
+}
+
+// SummarizeSteps builds the summary of the already simulated steps, it's
+// the same SummarizeGame returns for the options of the simulation.
+func SummarizeSteps(steps []SimulationStep) SimulationSummary {
+	var sum, sumSq float64
+	for _, s := range steps {
+		sum += s.FirstPlayerWin
+		sumSq += s.FirstPlayerWin * s.FirstPlayerWin
+	}
+
+	return summarize(len(steps), sum, sumSq)
+}
+
+func summarize(times int, sum, sumSq float64) SimulationSummary {
+	if times <= 0 {
+		return SimulationSummary{}
+	}
+
+	n := float64(times)
+	summary := SimulationSummary{
+		Times: times,
+		Mean:  sum / n,
+	}
+
+	if times > 1 {
+		summary.Variance = math.Max(0, (sumSq-n*summary.Mean*summary.Mean)/(n-1))
+	}
+
+	summary.StdErr = math.Sqrt(summary.Variance / n)
+	summary.ConfidenceLow = summary.Mean - ConfidenceZ*summary.StdErr
+	summary.ConfidenceHigh = summary.Mean + ConfidenceZ*summary.StdErr
+
+	return summary
+}
+
+func runShards(ctx context.Context, opt SimulationOptions, keepSteps bool) ([]*shard, error) {
+	if opt.Times <= 0 {
+		return nil, nil
+	}
+
+	seeder := rand.New(newSource(opt))
+	shards := make([]*shard, (opt.Times+shardSize-1)/shardSize)
+	for i := range shards {
+		shards[i] = &shard{
+			times:     min(shardSize, opt.Times-i*shardSize),
+			rnd:       rand.New(rand.NewSource(seeder.Int63())),
+			keepSteps: keepSteps,
+		}
+	}
+
+	workers := min(max(opt.Workers, 1), len(shards))
+	if workers == 1 {
+		for _, s := range shards {
+			if err := s.run(ctx, opt); err != nil {
+				return nil, err
+			}
+		}
+
+		return shards, nil
+	}
+
+	queue := make(chan *shard, len(shards))
+	for _, s := range shards {
+		queue <- s
+	}
+	close(queue)
+
+	var wg sync.WaitGroup
+	errs := make([]error, workers)
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for s := range queue {
+				if errs[i] = s.run(ctx, opt); errs[i] != nil {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, ctx.Err()
+	}
+
+	return shards, nil
+}
+
+func newSource(opt SimulationOptions) rand.Source {
+	if opt.Source != nil {
+		return opt.Source
+	}
+
+	if opt.Seed != nil {
+		return rand.NewSource(*opt.Seed)
+	}
+
+	return rand.NewSource(time.Now().UnixNano())
+}
+
+func (s *shard) run(ctx context.Context, opt SimulationOptions) error {
+	if s.keepSteps {
+		s.steps = make([]SimulationStep, 0, s.times)
+	}
+
+	for i := range s.times {
+		if i%cancelCheckEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		fn := s.rnd.Float64()
+		sn := s.rnd.Float64()
 		fs := GetStrategyFromNum(opt.FirstPlayerStrategy, fn)
 		ss := GetStrategyFromNum(opt.SecondPlayerStrategy, sn)
 		win := opt.Matrix.Rows[fs][ss]
-		totalWin += win
+		s.sum += win
+		s.sumSq += win * win
+
+		if !s.keepSteps {
+			continue
+		}
 
-		total = append(total, SimulationStep{
+		s.steps = append(s.steps, SimulationStep{
 			FirstPlayerRandomNum: fn,
 			FirstPlayerStrategy: matrix.Variable{
 				FirstStageName:  "x",
@@ -54,12 +245,12 @@ func SimulateGame(opt SimulationOptions) []SimulationStep {
 				SecondStageIndex: ss,
 			},
 			FirstPlayerWin:    win,
-			FirstPlayerWinSum: totalWin,
-			FirstPlayerWinAvg: totalWin / float64((i + 1)),
+			FirstPlayerWinSum: s.sum,
+			FirstPlayerWinAvg: s.sum / float64(i+1),
 		})
 	}
 
-	return total
+	return nil
 }
 
 func GetStrategyFromNum(weights Weights, n float64) int {
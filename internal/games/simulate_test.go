@@ -1,6 +1,16 @@
 package games
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
 
 func TestGetStrategyFromNum(t *testing.T) {
 	tc := []struct {
@@ -79,3 +89,258 @@ func TestGetStrategyFromNum(t *testing.T) {
 		})
 	}
 }
+
+func TestSimulateGameIsReproducible(t *testing.T) {
+	tc := []struct {
+		name    string
+		seed    int64
+		times   int
+		workers int
+	}{
+		{
+			name:    "Should reproduce single worker simulation",
+			seed:    42,
+			times:   100,
+			workers: 1,
+		},
+		{
+			name:    "Should reproduce sharded simulation",
+			seed:    42,
+			times:   101,
+			workers: 4,
+		},
+		{
+			name:    "Should reproduce simulation with zero seed",
+			seed:    0,
+			times:   100,
+			workers: 2,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			opt := SimulationOptions{
+				Matrix: matrix.Matrix{
+					Rows: []matrix.Row{
+						{1, -1},
+						{-1, 1},
+					},
+				},
+				FirstPlayerStrategy:  Weights{0.5, 0.5},
+				SecondPlayerStrategy: Weights{0.5, 0.5},
+				Times:                tt.times,
+				Seed:                 &tt.seed,
+				Workers:              tt.workers,
+			}
+
+			first, err := SimulateGame(context.Background(), opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			second, err := SimulateGame(context.Background(), opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(first, second) {
+				t.Fatalf("Expected simulations with the same seed to be equal")
+			}
+
+			if len(first) != tt.times {
+				t.Fatalf("Expected to get: %d steps, got: %d", tt.times, len(first))
+			}
+
+			var sum float64
+			for i, step := range first {
+				sum += step.FirstPlayerWin
+				if step.FirstPlayerWinSum != sum {
+					t.Fatalf("Expected sum at %d to be: %v, got: %v", i, sum, step.FirstPlayerWinSum)
+				}
+			}
+		})
+	}
+}
+
+func TestSummarizeGameIgnoresWorkers(t *testing.T) {
+	opt := SimulationOptions{
+		Matrix: matrix.Matrix{
+			Rows: []matrix.Row{
+				{4, -2, 1},
+				{-1, 3, 0},
+			},
+		},
+		FirstPlayerStrategy:  Weights{0.4, 0.6},
+		SecondPlayerStrategy: Weights{0.2, 0.5, 0.3},
+		Times:                3*shardSize + 17,
+		Seed:                 seed(11),
+	}
+
+	expected, err := SummarizeGame(context.Background(), opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, workers := range []int{1, 3, 8} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			t.Parallel()
+			opt := opt
+			opt.Workers = workers
+			got, err := SummarizeGame(context.Background(), opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != expected {
+				t.Fatalf("Expected to get: %+v, got: %+v", expected, got)
+			}
+		})
+	}
+}
+
+func TestSummarizeGame(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        matrix.Matrix
+		first    Weights
+		second   Weights
+		times    int
+		workers  int
+		mean     float64
+		variance float64
+	}{
+		{
+			name: "Should summarize constant game correctly",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{3, 3},
+					{3, 3},
+				},
+			},
+			first:    Weights{0.5, 0.5},
+			second:   Weights{0.5, 0.5},
+			times:    1000,
+			workers:  3,
+			mean:     3,
+			variance: 0,
+		},
+		{
+			name: "Should summarize pure strategy game correctly",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{1, 2},
+					{3, 4},
+				},
+			},
+			first:    Weights{0, 1},
+			second:   Weights{1, 0},
+			times:    10,
+			workers:  1,
+			mean:     3,
+			variance: 0,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SummarizeGame(context.Background(), SimulationOptions{
+				Matrix:               tt.m,
+				FirstPlayerStrategy:  tt.first,
+				SecondPlayerStrategy: tt.second,
+				Times:                tt.times,
+				Workers:              tt.workers,
+				Source:               rand.NewSource(1),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.Times != tt.times {
+				t.Fatalf("Expected to get: %v times, got: %v", tt.times, got.Times)
+			}
+
+			if math.Abs(got.Mean-tt.mean) > 1e-9 {
+				t.Fatalf("Expected to get mean: %v, got: %v", tt.mean, got.Mean)
+			}
+
+			if math.Abs(got.Variance-tt.variance) > 1e-9 {
+				t.Fatalf("Expected to get variance: %v, got: %v", tt.variance, got.Variance)
+			}
+
+			if got.ConfidenceLow > got.Mean || got.ConfidenceHigh < got.Mean {
+				t.Fatalf("Expected mean to be inside of confidence interval: %+v", got)
+			}
+		})
+	}
+}
+
+func TestSummarizeGameMatchesSteps(t *testing.T) {
+	opt := SimulationOptions{
+		Matrix: matrix.Matrix{
+			Rows: []matrix.Row{
+				{4, -2, 1},
+				{-1, 3, 0},
+			},
+		},
+		FirstPlayerStrategy:  Weights{0.4, 0.6},
+		SecondPlayerStrategy: Weights{0.2, 0.5, 0.3},
+		Times:                500,
+		Seed:                 seed(7),
+		Workers:              4,
+	}
+
+	steps, err := SimulateGame(context.Background(), opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summary, err := SummarizeGame(context.Background(), opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	last := steps[len(steps)-1]
+	if math.Abs(last.FirstPlayerWinAvg-summary.Mean) > 1e-9 {
+		t.Fatalf("Expected to get: %v, got: %v", last.FirstPlayerWinAvg, summary.Mean)
+	}
+
+	fromSteps := SummarizeSteps(steps)
+	if fromSteps.Times != summary.Times ||
+		math.Abs(fromSteps.Mean-summary.Mean) > 1e-9 ||
+		math.Abs(fromSteps.Variance-summary.Variance) > 1e-9 {
+		t.Fatalf("Expected to get: %+v, got: %+v", summary, fromSteps)
+	}
+}
+
+func TestSimulateGameCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	opt := SimulationOptions{
+		Matrix: matrix.Matrix{
+			Rows: []matrix.Row{
+				{1, -1},
+				{-1, 1},
+			},
+		},
+		FirstPlayerStrategy:  Weights{0.5, 0.5},
+		SecondPlayerStrategy: Weights{0.5, 0.5},
+		Times:                100000,
+		Seed:                 seed(1),
+		Workers:              4,
+	}
+
+	if _, err := SimulateGame(ctx, opt); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get: %v, got: %v", context.Canceled, err)
+	}
+
+	if _, err := SummarizeGame(ctx, opt); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get: %v, got: %v", context.Canceled, err)
+	}
+}
+
+func seed(n int64) *int64 {
+	return &n
+}
@@ -0,0 +1,73 @@
+package games
+
+import (
+	"context"
+	"math"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+type ZeroSumSolution struct {
+	// Clean is set when the game has a saddle point, the rest of the
+	// simplex related fields are empty in that case.
+	Clean                *matrix.MinMax
+	Shift                float64
+	Corrected            matrix.Matrix
+	Support              inequations.Solution
+	Optimal              *inequations.DoubledOptimalSolution
+	GameWeight           float64
+	FirstPlayerStrategy  Weights
+	SecondPlayerStrategy Weights
+}
+
+func SolveZeroSum(ctx context.Context, m matrix.Matrix) (*ZeroSumSolution, error) {
+	if len(m.Rows) == 0 || len(m.Rows[0]) == 0 {
+		return nil, ErrEmptyMatrix
+	}
+
+	if clean, err := m.GetCleanStrategySolution(); err == nil {
+		first := make(Weights, len(m.Rows))
+		second := make(Weights, len(m.Rows[0]))
+		first[clean.Row], second[clean.Col] = 1, 1
+		return &ZeroSumSolution{
+			Clean:                clean,
+			GameWeight:           clean.Val,
+			FirstPlayerStrategy:  first,
+			SecondPlayerStrategy: second,
+		}, nil
+	}
+
+	minabs := math.Abs(m.Min())
+	compat, err := CompleteMatrixToCompatible(*m.Add(minabs))
+	if err != nil {
+		return nil, err
+	}
+
+	corrected := *compat
+	corrected.InitialCols = len(corrected.Rows[0])
+	corrected.InitialRows = len(corrected.Rows) - 1
+
+	support, err := inequations.FindSupportSolution(ctx, corrected.Copy())
+	if err != nil {
+		return nil, err
+	}
+
+	optimal, err := inequations.FindMaxDoubledWithOptimalSolution(ctx, support.Matrix)
+	if err != nil {
+		return nil, err
+	}
+
+	weight := GetGameWeight(optimal.MaxSolution.Matrix)
+	first := CorrectMixedStrategy(optimal.MaxSolution.Result, weight)
+	second := CorrectMixedStrategy(optimal.MinSolution.Result, weight)
+	return &ZeroSumSolution{
+		Shift:                minabs,
+		Corrected:            corrected,
+		Support:              support,
+		Optimal:              optimal,
+		GameWeight:           CorrectGameWeight(weight, minabs),
+		FirstPlayerStrategy:  first[:min(len(first), len(m.Rows))],
+		SecondPlayerStrategy: second[:min(len(second), len(m.Rows[0]))],
+	}, nil
+}
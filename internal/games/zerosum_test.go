@@ -0,0 +1,86 @@
+package games
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestSolveZeroSum(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        matrix.Matrix
+		weight   float64
+		first    Weights
+		second   Weights
+		clean    bool
+		expected error
+	}{
+		{
+			name: "Should find clean solution",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{1, 2},
+					{3, 4},
+				},
+			},
+			weight: 3,
+			first:  Weights{0, 1},
+			second: Weights{1, 0},
+			clean:  true,
+		},
+		{
+			name: "Should find mixed solution",
+			m: matrix.Matrix{
+				Rows: []matrix.Row{
+					{1, -1},
+					{-1, 1},
+				},
+			},
+			weight: 0,
+			first:  Weights{0.5, 0.5},
+			second: Weights{0.5, 0.5},
+		},
+		{
+			name:     "Should reject empty matrix",
+			m:        matrix.Matrix{},
+			expected: ErrEmptyMatrix,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SolveZeroSum(context.Background(), tt.m)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if (got.Clean != nil) != tt.clean {
+				t.Fatalf("Expected clean solution: %v, got: %+v", tt.clean, got.Clean)
+			}
+
+			if got.GameWeight != tt.weight {
+				t.Fatalf("Expected to get weight: %v, got: %v", tt.weight, got.GameWeight)
+			}
+
+			if !reflect.DeepEqual(got.FirstPlayerStrategy, tt.first) ||
+				!reflect.DeepEqual(got.SecondPlayerStrategy, tt.second) {
+				t.Fatalf(
+					"Expected to get: %v %v, got: %v %v",
+					tt.first,
+					tt.second,
+					got.FirstPlayerStrategy,
+					got.SecondPlayerStrategy,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// Bound limits an x variable to [Lower; Upper], infinite ends are allowed.
+type Bound struct {
+	Lower float64
+	Upper float64
+}
+
+var (
+	NonNegative = Bound{Lower: 0, Upper: math.Inf(1)}
+	Free        = Bound{Lower: math.Inf(-1), Upper: math.Inf(1)}
+)
+
+// maxBoundedIterations stops the degenerate pivots from cycling forever.
+const maxBoundedIterations = 10000
+
+var (
+	ErrInvalidBound = errors.New("lower bound should not exceed upper bound")
+	ErrUnbounded    = errors.New("objective is unbounded")
+	ErrCycling      = errors.New("simplex is cycling")
+)
+
+func (b Bound) Validate() error {
+	if math.IsNaN(b.Lower) || math.IsNaN(b.Upper) || b.Lower > b.Upper ||
+		math.IsInf(b.Lower, 1) || math.IsInf(b.Upper, -1) {
+		return ErrInvalidBound
+	}
+
+	return nil
+}
+
+// boundedVariable relates the tableau variable t to the initial one:
+// v = shift + sign*t. Only free variables may get below zero, the rest
+// are limited to [0; upper], so the nonbasic variables always equal 0.
+type boundedVariable struct {
+	shift float64
+	sign  float64
+	upper float64
+	free  bool
+}
+
+func (v *boundedVariable) fixed() bool {
+	return !v.free && v.upper <= eps
+}
+
+func (v *boundedVariable) lower() float64 {
+	if v.free {
+		return math.Inf(-1)
+	}
+
+	return 0
+}
+
+type boundedTableau struct {
+	m         matrix.Matrix
+	variables map[matrix.Variable]*boundedVariable
+}
+
+// SolveBoundedMax maximizes the tableau with the x variables limited by
+// the bounds instead of additional rows, missing bounds are NonNegative.
+// Equations ("0" rows) don't need DeleteZeros: their slacks are fixed.
+// Support is the first feasible solution.
+func SolveBoundedMax(ctx context.Context, m matrix.Matrix, bounds []Bound) (*Result, error) {
+	t, err := newBoundedTableau(m, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.solve(ctx, 1)
+}
+
+func SolveBoundedMin(ctx context.Context, m matrix.Matrix, bounds []Bound) (*Result, error) {
+	t, err := newBoundedTableau(m, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range t.m.Rows[len(t.m.Rows)-1] {
+		t.m.Rows[len(t.m.Rows)-1][i] *= -1
+	}
+
+	return t.solve(ctx, -1)
+}
+
+func newBoundedTableau(m matrix.Matrix, bounds []Bound) (*boundedTableau, error) {
+	if len(m.Rows) < 2 || len(m.Rows[0]) < 2 {
+		return nil, errors.New("tableau should have constraints, variables and the free terms")
+	}
+
+	t := &boundedTableau{
+		m:         m.Copy(),
+		variables: make(map[matrix.Variable]*boundedVariable),
+	}
+
+	if len(t.m.LeftTitle) == 0 {
+		t.m.FillLeftTitle()
+	}
+	if len(t.m.TopTitle) == 0 {
+		t.m.FillTopTitle()
+	}
+	t.m.LeftTitle = append([]matrix.Variable(nil), t.m.LeftTitle...)
+	t.m.TopTitle = append([]matrix.Variable(nil), t.m.TopTitle...)
+
+	lastRow, lastCol := len(t.m.Rows)-1, len(t.m.Rows[0])-1
+	for j, title := range t.m.TopTitle[:lastCol] {
+		v, err := newBoundedVariable(title, bounds)
+		if err != nil {
+			return nil, err
+		}
+
+		t.variables[title] = v
+		for _, row := range t.m.Rows {
+			row[lastCol] -= row[j] * v.shift
+			row[j] *= v.sign
+		}
+	}
+
+	for i, title := range t.m.LeftTitle[:lastRow] {
+		v, err := newBoundedVariable(title, bounds)
+		if err != nil {
+			return nil, err
+		}
+
+		t.variables[title] = v
+		row := t.m.Rows[i]
+		row[lastCol] -= v.shift
+		for j := range row {
+			row[j] *= v.sign
+		}
+	}
+
+	return t, nil
+}
+
+func newBoundedVariable(title matrix.Variable, bounds []Bound) (*boundedVariable, error) {
+	b := NonNegative
+	switch {
+	case title.IsZero():
+		b = Bound{}
+	case title.IsX() && title.FirstStageIndex < len(bounds):
+		b = bounds[title.FirstStageIndex]
+	}
+
+	if err := b.Validate(); err != nil {
+		return nil, fmt.Errorf("%v: %w", title, err)
+	}
+
+	switch {
+	case math.IsInf(b.Lower, -1) && math.IsInf(b.Upper, 1):
+		return &boundedVariable{sign: 1, upper: math.Inf(1), free: true}, nil
+	case math.IsInf(b.Lower, -1):
+		return &boundedVariable{shift: b.Upper, sign: -1, upper: math.Inf(1)}, nil
+	}
+
+	return &boundedVariable{shift: b.Lower, sign: 1, upper: b.Upper - b.Lower}, nil
+}
+
+// solve runs the first phase, which minimizes the sum of the bound
+// violations, and then the second one over the feasible solutions.
+func (t *boundedTableau) solve(ctx context.Context, sign float64) (*Result, error) {
+	var (
+		res      Result
+		feasible bool
+	)
+
+	for range maxBoundedIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		stage := OptimalStage
+		col, gradient := -1, matrix.Row(nil)
+		if !feasible {
+			stage, gradient = SupportStage, t.infeasibility()
+			if gradient == nil {
+				feasible = true
+				res.Support = t.solution()
+				continue
+			}
+		} else {
+			gradient = t.m.Rows[len(t.m.Rows)-1]
+		}
+
+		col = t.entering(gradient)
+		if col == -1 {
+			if !feasible {
+				return nil, ErrInfeasible
+			}
+
+			res.Optimal = t.solution()
+			res.Objective = sign * t.m.Rows[len(t.m.Rows)-1][len(t.m.Rows[0])-1]
+			return &res, nil
+		}
+
+		if gradient[col] > 0 {
+			t.negate(col)
+		}
+
+		if err := t.step(ctx, stage, col); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, ErrCycling
+}
+
+// infeasibility returns the gradient of the bound violations sum over
+// the nonbasic variables, nil when every basic variable is in bounds.
+func (t *boundedTableau) infeasibility() matrix.Row {
+	lastRow, lastCol := len(t.m.Rows)-1, len(t.m.Rows[0])-1
+	var gradient matrix.Row
+	for i, row := range t.m.Rows[:lastRow] {
+		v, b := t.variables[t.m.LeftTitle[i]], row[lastCol]
+		sign := 0.
+		switch {
+		case b < v.lower()-eps:
+			sign = 1
+		case b > v.upper+eps:
+			sign = -1
+		default:
+			continue
+		}
+
+		if gradient == nil {
+			gradient = make(matrix.Row, lastCol)
+		}
+
+		for j := range gradient {
+			gradient[j] += sign * row[j]
+		}
+	}
+
+	return gradient
+}
+
+// entering picks the first variable which may improve the gradient: it
+// either grows from 0 or is free and decreases.
+func (t *boundedTableau) entering(gradient matrix.Row) int {
+	lastCol := len(t.m.Rows[0]) - 1
+	for j, title := range t.m.TopTitle[:lastCol] {
+		v := t.variables[title]
+		if v.fixed() {
+			continue
+		}
+
+		if gradient[j] < -eps || (v.free && gradient[j] > eps) {
+			return j
+		}
+	}
+
+	return -1
+}
+
+// step increases the entering variable until either it reaches its own
+// upper bound or some basic variable reaches one of its bounds.
+func (t *boundedTableau) step(ctx context.Context, stage matrix.Stage, col int) error {
+	lastRow, lastCol := len(t.m.Rows)-1, len(t.m.Rows[0])-1
+	theta, row, atUpper := t.variables[t.m.TopTitle[col]].upper, -1, false
+	for i, r := range t.m.Rows[:lastRow] {
+		v, a, b := t.variables[t.m.LeftTitle[i]], r[col], r[lastCol]
+		limit, upper := math.Inf(1), false
+		switch {
+		case a > eps && b > v.upper+eps:
+			limit, upper = (b-v.upper)/a, true
+		case a > eps && b >= v.lower()-eps:
+			limit = max(b-v.lower(), 0) / a
+		case a < -eps && b < v.lower()-eps:
+			limit = (v.lower() - b) / -a
+		case a < -eps && b <= v.upper+eps:
+			limit, upper = max(v.upper-b, 0)/-a, true
+		}
+
+		if limit < theta {
+			theta, row, atUpper = limit, i, upper
+		}
+	}
+
+	if math.IsInf(theta, 1) {
+		return ErrUnbounded
+	}
+
+	if row == -1 {
+		t.flip(col)
+		return nil
+	}
+
+	before := matrix.PivotSnapshot(ctx, t.m)
+	m, err := t.m.JordanEliminateModified(col, row)
+	if err != nil {
+		return err
+	}
+	t.m = m
+
+	if atUpper {
+		t.flip(col)
+	}
+
+	matrix.NotifyPivot(ctx, stage, row, col, before, t.m)
+
+	matrix.PrintStep(ctx, t.m, "\nElement col: %v row: %v, Matrix:\n\n", col, row)
+	return nil
+}
+
+// flip moves the nonbasic variable to its upper bound: t = upper - t'.
+func (t *boundedTableau) flip(col int) {
+	v := t.variables[t.m.TopTitle[col]]
+	lastCol := len(t.m.Rows[0]) - 1
+	for _, row := range t.m.Rows {
+		row[lastCol] -= row[col] * v.upper
+		row[col] *= -1
+	}
+
+	v.shift += v.sign * v.upper
+	v.sign *= -1
+}
+
+// negate lets the free variable decrease: t = -t'.
+func (t *boundedTableau) negate(col int) {
+	for _, row := range t.m.Rows {
+		row[col] *= -1
+	}
+
+	t.variables[t.m.TopTitle[col]].sign *= -1
+}
+
+func (t *boundedTableau) solution() Solution {
+	lastCol := len(t.m.Rows[0]) - 1
+	res := make([]float64, t.m.GetXCount())
+	for i, title := range t.m.LeftTitle {
+		if title.IsX() {
+			v := t.variables[title]
+			res[title.FirstStageIndex] = matrix.RoundTo(v.shift+v.sign*t.m.Rows[i][lastCol], 2)
+		}
+	}
+
+	for _, title := range t.m.TopTitle {
+		if title.IsX() {
+			res[title.FirstStageIndex] = matrix.RoundTo(t.variables[title].shift, 2)
+		}
+	}
+
+	m := t.m.Copy()
+	m.LeftTitle = append([]matrix.Variable(nil), t.m.LeftTitle...)
+	m.TopTitle = append([]matrix.Variable(nil), t.m.TopTitle...)
+	return Solution{Matrix: m, Result: res}
+}
@@ -0,0 +1,192 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestSolveBounded(t *testing.T) {
+	inf := math.Inf(1)
+	equation := tableau(matrix.Row{1, 1, 4}, matrix.Row{-1, 0, 0})
+	equation.LeftTitle[0] = matrix.Variable{FirstStageName: "0", SecondStageName: "u"}
+
+	tc := []struct {
+		name      string
+		m         matrix.Matrix
+		bounds    []Bound
+		minimize  bool
+		optimal   []float64
+		objective float64
+		err       error
+	}{
+		{
+			name:      "Should respect upper bound",
+			m:         tableau(matrix.Row{1, 1, 4}, matrix.Row{1, 3, 6}, matrix.Row{-3, -2, 0}),
+			bounds:    []Bound{{Lower: 0, Upper: 3}, NonNegative},
+			optimal:   []float64{3, 1},
+			objective: 11,
+		},
+		{
+			name:      "Should treat missing bounds as non-negative",
+			m:         tableau(matrix.Row{1, 1, 4}, matrix.Row{1, 3, 6}, matrix.Row{-3, -2, 0}),
+			optimal:   []float64{4, 0},
+			objective: 12,
+		},
+		{
+			name:      "Should find free variable below zero",
+			m:         tableau(matrix.Row{-1, 3}, matrix.Row{-1, 0}),
+			bounds:    []Bound{Free},
+			minimize:  true,
+			optimal:   []float64{-3},
+			objective: -3,
+		},
+		{
+			name:      "Should respect lower bound",
+			m:         tableau(matrix.Row{-1, -1, -3}, matrix.Row{-1, -2, 0}),
+			bounds:    []Bound{{Lower: 2, Upper: inf}, NonNegative},
+			minimize:  true,
+			optimal:   []float64{3, 0},
+			objective: 3,
+		},
+		{
+			name:      "Should respect upper bound without lower one",
+			m:         tableau(matrix.Row{1, 1, 10}, matrix.Row{-1, 1, 0}),
+			bounds:    []Bound{{Lower: math.Inf(-1), Upper: 5}, NonNegative},
+			optimal:   []float64{5, 0},
+			objective: 5,
+		},
+		{
+			name:      "Should keep equations without deleting zeros",
+			m:         equation,
+			bounds:    []Bound{{Lower: 0, Upper: 3}, NonNegative},
+			optimal:   []float64{3, 1},
+			objective: 3,
+		},
+		{
+			name:   "Should not allow infeasible bounds",
+			m:      tableau(matrix.Row{1, 3}, matrix.Row{-1, 0}),
+			bounds: []Bound{{Lower: 5, Upper: inf}},
+			err:    ErrInfeasible,
+		},
+		{
+			name:   "Should not allow unbounded objective",
+			m:      tableau(matrix.Row{0, 1, 1}, matrix.Row{-1, 0, 0}),
+			bounds: []Bound{Free, NonNegative},
+			err:    ErrUnbounded,
+		},
+		{
+			name:   "Should not allow invalid bound",
+			m:      tableau(matrix.Row{1, 3}, matrix.Row{-1, 0}),
+			bounds: []Bound{{Lower: 5, Upper: 1}},
+			err:    ErrInvalidBound,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			solve := SolveBoundedMax
+			if tt.minimize {
+				solve = SolveBoundedMin
+			}
+
+			got, err := solve(context.Background(), tt.m, tt.bounds)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !slices.Equal(got.Optimal.Result, tt.optimal) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.optimal, got.Optimal.Result)
+			}
+
+			if math.Abs(got.Objective-tt.objective) > eps {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, got.Objective)
+			}
+		})
+	}
+}
+
+func TestSolveBoundedMatchesUpperBoundRows(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(1))
+	for i := range 30 {
+		rows, cols := 2+r.Intn(3), 2+r.Intn(3)
+		bounds := make([]Bound, cols)
+		m := make([]matrix.Row, 0, rows+cols+1)
+		for range rows {
+			row := make(matrix.Row, cols+1)
+			for j := range cols {
+				row[j] = float64(r.Intn(6))
+			}
+			row[cols] = float64(1 + r.Intn(10))
+			m = append(m, row)
+		}
+
+		for j := range bounds {
+			bounds[j] = Bound{Upper: float64(1 + r.Intn(5))}
+			row := make(matrix.Row, cols+1)
+			row[j], row[cols] = 1, bounds[j].Upper
+			m = append(m, row)
+		}
+
+		z := make(matrix.Row, cols+1)
+		for j := range cols {
+			z[j] = -float64(1 + r.Intn(5))
+		}
+
+		expected, err := SolveMax(context.Background(), tableau(append(m, z)...), SimplexMethod)
+		if err != nil {
+			t.Fatalf("%d: Expected to get no error, got: %v", i, err)
+		}
+
+		got, err := SolveBoundedMax(context.Background(), tableau(append(m[:rows:rows], z)...), bounds)
+		if err != nil {
+			t.Fatalf("%d: Expected to get no error, got: %v", i, err)
+		}
+
+		if math.Abs(got.Objective-expected.Objective) > 1e-6 {
+			t.Fatalf("%d: Expected to get: %v, got: %v", i, expected.Objective, got.Objective)
+		}
+	}
+}
+
+func TestSolveMaxBoundedMethod(t *testing.T) {
+	t.Parallel()
+	m := func() matrix.Matrix {
+		return tableau(matrix.Row{1, 1, 4}, matrix.Row{1, 3, 6}, matrix.Row{-3, -2, 0})
+	}
+
+	expected, err := SolveMax(context.Background(), m(), SimplexMethod)
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	got, err := SolveMax(context.Background(), m(), BoundedMethod)
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	if got.Objective != expected.Objective || !slices.Equal(got.Optimal.Result, expected.Optimal.Result) {
+		t.Fatalf("Expected to get: %v, got: %v", expected.Optimal.Result, got.Optimal.Result)
+	}
+}
+
+func TestSolveBoundedCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	m := tableau(matrix.Row{1, 1, 4}, matrix.Row{-3, -2, 0})
+	if _, err := SolveBoundedMax(ctx, m, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get: %v, got: %v", context.Canceled, err)
+	}
+}
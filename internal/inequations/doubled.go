@@ -1,6 +1,8 @@
 package inequations
 
 import (
+	"context"
+
 	"github.com/hrvadl/algo/internal/matrix"
 )
 
@@ -14,66 +16,74 @@ type DoubledSolution struct {
 	Max Solution
 }
 
-func FindMinDoubledWithOptimalSolution(m matrix.Matrix) (*DoubledOptimalSolution, error) {
-	optimal, err := FindMinWithOptimalSolution(m)
+func FindMinDoubledWithOptimalSolution(
+	ctx context.Context,
+	m matrix.Matrix,
+) (*DoubledOptimalSolution, error) {
+	optimal, err := FindMinWithOptimalSolution(ctx, m)
 	if err != nil {
 		return nil, err
 	}
 
-	minResLen := optimal.Matrix.InitialCols
-	if minResLen == 0 {
-		minResLen = optimal.Matrix.GetUCount()
-	}
-
-	res := DoubledOptimalSolution{
+	return &DoubledOptimalSolution{
 		MinSolution: *optimal,
 		MaxSolution: MaxSolution{
 			Solution: Solution{
 				Matrix: optimal.Matrix,
-				Result: make([]float64, minResLen),
+				Result: dualResult(optimal.Matrix),
 			},
 			Max: optimal.Min,
 		},
-	}
-
-	lastRow := len(optimal.Matrix.Rows) - 1
-	for col, variable := range optimal.Matrix.TopTitle {
-		if variable.IsU() {
-			res.MaxSolution.Result[variable.SecondStageIndex] = optimal.Matrix.Rows[lastRow][col]
-		}
-	}
-
-	return &res, nil
+	}, nil
 }
 
-func FindMaxDoubledWithOptimalSolution(m matrix.Matrix) (*DoubledOptimalSolution, error) {
-	optimal, err := FindMaxWithOptimalSolution(m)
+func FindMaxDoubledWithOptimalSolution(
+	ctx context.Context,
+	m matrix.Matrix,
+) (*DoubledOptimalSolution, error) {
+	optimal, err := FindMaxWithOptimalSolution(ctx, m)
 	if err != nil {
 		return nil, err
 	}
 
-	maxResLen := optimal.Matrix.InitialCols
-	if maxResLen == 0 {
-		maxResLen = optimal.Matrix.GetUCount()
-	}
-
-	res := DoubledOptimalSolution{
+	return &DoubledOptimalSolution{
 		MaxSolution: *optimal,
 		MinSolution: MinSolution{
 			Solution: Solution{
 				Matrix: optimal.Matrix,
-				Result: make([]float64, maxResLen),
+				Result: dualResult(optimal.Matrix),
 			},
 			Min: optimal.Max,
 		},
+	}, nil
+}
+
+// dualResult reads the u variables from the last row of the optimal tableau.
+func dualResult(m matrix.Matrix) []float64 {
+	res := make([]float64, dualLength(m))
+	lastRow := len(m.Rows) - 1
+	for col, variable := range m.TopTitle {
+		if variable.IsU() {
+			res[variable.SecondStageIndex] = m.Rows[lastRow][col]
+		}
+	}
+
+	return res
+}
+
+// dualLength fits every u variable, there may be more of them than
+// initial columns when the problem has more constraints than variables.
+func dualLength(m matrix.Matrix) int {
+	n := m.InitialCols
+	if n == 0 {
+		n = m.GetUCount()
 	}
 
-	lastRow := len(optimal.Matrix.Rows) - 1
-	for col, variable := range optimal.Matrix.TopTitle {
+	for _, variable := range m.TopTitle {
 		if variable.IsU() {
-			res.MinSolution.Result[variable.SecondStageIndex] = optimal.Matrix.Rows[lastRow][col]
+			n = max(n, variable.SecondStageIndex+1)
 		}
 	}
 
-	return &res, nil
+	return n
 }
@@ -1,6 +1,7 @@
 package inequations
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -215,7 +216,7 @@ func TestFindMaxDoubledWithSupportSolution(t *testing.T) {
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			if actual, _ := FindSupportSolution(tt.m); !reflect.DeepEqual(
+			if actual, _ := FindSupportSolution(context.Background(), tt.m); !reflect.DeepEqual(
 				actual,
 				tt.expected.Max,
 			) {
@@ -548,12 +549,12 @@ func TestFindMaxDoubledWithOptimalSolution(t *testing.T) {
 
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
-			support, err := FindSupportSolution(tt.m)
+			support, err := FindSupportSolution(context.Background(), tt.m)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			actual, err := FindMaxDoubledWithOptimalSolution(support.Matrix)
+			actual, err := FindMaxDoubledWithOptimalSolution(context.Background(), support.Matrix)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -568,3 +569,34 @@ func TestFindMaxDoubledWithOptimalSolution(t *testing.T) {
 		})
 	}
 }
+
+func TestFindDoubledWithMoreConstraintsThanVariables(t *testing.T) {
+	m := matrix.Matrix{
+		Rows: []matrix.Row{
+			{1, 0, 4},
+			{0, 1, 3},
+			{1, 1, 6},
+			{1, -1, 1},
+			{-1, -1, 0},
+		},
+	}
+	m.FillTopTitle()
+	m.FillLeftTitle()
+	m.InitialCols = len(m.Rows[0])
+	m.InitialRows = len(m.Rows) - 1
+
+	support, err := FindSupportSolution(context.Background(), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := FindMaxDoubledWithOptimalSolution(context.Background(), support.Matrix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []float64{0, 0, 1, 0}
+	if !reflect.DeepEqual(actual.MinSolution.Result, expected) {
+		t.Fatalf("want: %v\ngot:%v", expected, actual.MinSolution.Result)
+	}
+}
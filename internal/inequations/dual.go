@@ -0,0 +1,83 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const DualStage matrix.Stage = "dual"
+
+// eps keeps the rounding errors from being taken for negative elements.
+const eps = 1e-9
+
+var (
+	ErrNotDualFeasible   = errors.New("tableau is not optimal: the last row has negative elements")
+	ErrInfeasible        = errors.New("problem has no feasible solution")
+	ErrUnknownConstraint = errors.New("constraint is not in the tableau")
+)
+
+// FindDualSolution is the dual simplex method: the tableau should be
+// optimal but may have negative free terms, every pivot removes one of
+// them and keeps the last row non-negative.
+func FindDualSolution(ctx context.Context, m matrix.Matrix) (*Solution, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	lastRow := len(m.Rows) - 1
+	lastCol := len(m.Rows[0]) - 1
+	for _, el := range m.Rows[lastRow][:lastCol] {
+		if el < -eps {
+			return nil, ErrNotDualFeasible
+		}
+	}
+
+	row := -1
+	for i := range lastRow {
+		if m.Rows[i][lastCol] < -eps && (row == -1 || m.Rows[i][lastCol] < m.Rows[row][lastCol]) {
+			row = i
+		}
+	}
+
+	if row == -1 {
+		res := make([]float64, m.GetXCount())
+		for i, variable := range m.LeftTitle {
+			if variable.IsX() {
+				res[variable.FirstStageIndex] = matrix.RoundTo(m.Rows[i][lastCol], 2)
+			}
+		}
+
+		return &Solution{Matrix: m, Result: res}, nil
+	}
+
+	col := -1
+	for j, el := range m.Rows[row][:lastCol] {
+		if el >= -eps {
+			continue
+		}
+
+		ratio := m.Rows[lastRow][j] / -el
+		if col == -1 || ratio < m.Rows[lastRow][col]/-m.Rows[row][col] {
+			col = j
+		}
+	}
+
+	if col == -1 {
+		return nil, fmt.Errorf("%w: row %v can't become non-negative", ErrInfeasible, row)
+	}
+
+	before := matrix.PivotSnapshot(ctx, m)
+	m, err := m.JordanEliminateModified(col, row)
+	if err != nil {
+		return nil, err
+	}
+
+	matrix.NotifyPivot(ctx, DualStage, row, col, before, m)
+
+	matrix.PrintStep(ctx, m, "\nElement col: %v row: %v, Matrix:\n\n", col, row)
+
+	return FindDualSolution(ctx, m)
+}
@@ -0,0 +1,91 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestFindDualSolution(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        matrix.Matrix
+		expected matrix.Row
+		err      error
+	}{
+		{
+			name: "Should keep feasible tableau",
+			m: tableau(
+				matrix.Row{1, 1, 4},
+				matrix.Row{0, 1, 2},
+				matrix.Row{1, 2, 8},
+			),
+			expected: matrix.Row{0, 0},
+		},
+		{
+			name: "Should restore feasibility",
+			m: tableau(
+				matrix.Row{-1, -1, -2},
+				matrix.Row{-1, 2, -1},
+				matrix.Row{2, 1, 0},
+			),
+			expected: matrix.Row{1.67, 0.33},
+		},
+		{
+			name: "Should not allow negative last row",
+			m: tableau(
+				matrix.Row{-1, -1, -2},
+				matrix.Row{-1, 1, 0},
+			),
+			err: ErrNotDualFeasible,
+		},
+		{
+			name: "Should not allow infeasible row",
+			m: tableau(
+				matrix.Row{1, 1, -2},
+				matrix.Row{1, 1, 0},
+			),
+			err: ErrInfeasible,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := FindDualSolution(context.Background(), tt.m)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !slices.Equal(actual.Result, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, actual.Result)
+			}
+		})
+	}
+}
+
+func TestFindDualSolutionCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	m := tableau(matrix.Row{-1, -1, -2}, matrix.Row{1, 1, 0})
+	if _, err := FindDualSolution(ctx, m); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get: %v, got: %v", context.Canceled, err)
+	}
+}
+
+func tableau(rows ...matrix.Row) matrix.Matrix {
+	m := matrix.Matrix{Rows: rows}
+	m.FillLeftTitle()
+	m.FillTopTitle()
+	m.InitialRows = len(rows) - 1
+	m.InitialCols = len(rows[0])
+	return m
+}
@@ -1,11 +1,16 @@
 package inequations
 
 import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
 	"github.com/hrvadl/algo/internal/matrix"
 )
 
-func FindMinIntegerSolution(m matrix.Matrix) (*MinSolution, *Solution, error) {
-	optimal, support, err := FindIntegerSolution(m)
+func FindMinIntegerSolution(ctx context.Context, m matrix.Matrix) (*MinSolution, *Solution, error) {
+	optimal, support, err := FindIntegerSolution(ctx, m)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -19,8 +24,8 @@ func FindMinIntegerSolution(m matrix.Matrix) (*MinSolution, *Solution, error) {
 	}, support, nil
 }
 
-func FindMaxIntegerSolution(m matrix.Matrix) (*MaxSolution, *Solution, error) {
-	optimal, support, err := FindIntegerSolution(m)
+func FindMaxIntegerSolution(ctx context.Context, m matrix.Matrix) (*MaxSolution, *Solution, error) {
+	optimal, support, err := FindIntegerSolution(ctx, m)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -34,26 +39,242 @@ func FindMaxIntegerSolution(m matrix.Matrix) (*MaxSolution, *Solution, error) {
 	}, support, nil
 }
 
-func FindIntegerSolution(m matrix.Matrix) (*Solution, *Solution, error) {
-	support, err := FindSupportSolution(m)
+// FindIntegerSolution is the Gomory's cutting plane method. Every cut is
+// written with the x variables of m, added with AddConstraint and made
+// feasible by the dual simplex, so the optimal tableau is never solved
+// from scratch.
+func FindIntegerSolution(ctx context.Context, m matrix.Matrix) (*Solution, *Solution, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	// the solvers swap the titles in place, so m is read before them
+	slacks, err := slackDefinitions(m)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	optimal, err := FindOptimalSolution(support.Matrix)
+	support, err := FindSupportSolution(ctx, m)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	lastCol := len(optimal.Matrix.Rows[0]) - 1
-	for row, variable := range optimal.Matrix.LeftTitle {
-		el := matrix.RoundTo(optimal.Matrix.Rows[row][lastCol], 2)
+	optimal, err := FindOptimalSolution(ctx, support.Matrix)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		row := fractionalRow(optimal.Matrix)
+		if row == -1 {
+			return optimal, &support, nil
+		}
+
+		cut, err := gomoryCut(optimal.Matrix, row, slacks)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r := Result{Optimal: *optimal}
+		if err := r.AddConstraint(cut.coefficients, cut.rhs); err != nil {
+			return nil, nil, err
+		}
+
+		added := r.Optimal.Matrix.LeftTitle[len(r.Optimal.Matrix.LeftTitle)-2]
+		slacks[added] = cut
+
+		if optimal, err = FindDualSolution(ctx, r.Optimal.Matrix); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// linear is rhs - coefficients*x, the way a slack is written with the x
+// variables of the initial tableau.
+type linear struct {
+	coefficients []float64
+	rhs          float64
+}
+
+// slackDefinitions writes every variable of the initial tableau but x
+// and z with its x variables. The columns may be not x after the
+// equations are deleted, they are found from the x rows by the
+// Gauss-Jordan elimination.
+func slackDefinitions(m matrix.Matrix) (map[matrix.Variable]linear, error) {
+	columns, err := columnDefinitions(m)
+	if err != nil {
+		return nil, err
+	}
+
+	n := m.GetXCount()
+	lastRow := len(m.Rows) - 1
+	lastCol := len(m.Rows[0]) - 1
+	res := make(map[matrix.Variable]linear, lastRow+lastCol)
+	for j, variable := range m.TopTitle[:lastCol] {
+		if !variable.IsX() {
+			res[variable] = columns[j]
+		}
+	}
+
+	for i, variable := range m.LeftTitle[:lastRow] {
+		if variable.IsX() {
+			continue
+		}
+
+		// v = b - sum(a_j*t_j), t_j = rhs_j - c_j*x
+		l := linear{coefficients: make([]float64, n), rhs: m.Rows[i][lastCol]}
+		for j, a := range m.Rows[i][:lastCol] {
+			l.rhs -= a * columns[j].rhs
+			for k, c := range columns[j].coefficients {
+				l.coefficients[k] -= a * c
+			}
+		}
+
+		res[variable] = l
+	}
+
+	return res, nil
+}
+
+// columnDefinitions solves x = b - sum(a_j*t_j) of the x rows and the
+// x columns t_j = x for the columns of m.
+func columnDefinitions(m matrix.Matrix) ([]linear, error) {
+	n := m.GetXCount()
+	lastCol := len(m.Rows[0]) - 1
+
+	// every equation is sum(a_j*t_j) = rhs - coefficients*x
+	var (
+		a   [][]float64
+		rhs []linear
+	)
+
+	equation := func(x int, b, sign float64) linear {
+		l := linear{coefficients: make([]float64, n), rhs: b}
+		l.coefficients[x] = sign
+		return l
+	}
+
+	for j, variable := range m.TopTitle[:lastCol] {
+		if variable.IsX() {
+			row := make([]float64, lastCol)
+			row[j] = 1
+			a = append(a, row)
+			rhs = append(rhs, equation(variable.FirstStageIndex, 0, -1))
+		}
+	}
+
+	// x = b - a*t is a*t = b - x
+	for i, variable := range m.LeftTitle {
+		if variable.IsX() {
+			a = append(a, slices.Clone(m.Rows[i][:lastCol]))
+			rhs = append(rhs, equation(variable.FirstStageIndex, m.Rows[i][lastCol], 1))
+		}
+	}
+
+	for col := range lastCol {
+		pivot := col
+		for i := col + 1; i < len(a); i++ {
+			if math.Abs(a[i][col]) > math.Abs(a[pivot][col]) {
+				pivot = i
+			}
+		}
+
+		if pivot >= len(a) || math.Abs(a[pivot][col]) < eps {
+			return nil, fmt.Errorf("%w: column %v is not defined by x", ErrUnknownConstraint, m.TopTitle[col])
+		}
+
+		a[col], a[pivot] = a[pivot], a[col]
+		rhs[col], rhs[pivot] = rhs[pivot], rhs[col]
+		for i := range a {
+			if i == col || a[i][col] == 0 {
+				continue
+			}
+
+			factor := a[i][col] / a[col][col]
+			for j := range a[i] {
+				a[i][j] -= factor * a[col][j]
+			}
+
+			rhs[i].rhs -= factor * rhs[col].rhs
+			for k := range rhs[i].coefficients {
+				rhs[i].coefficients[k] -= factor * rhs[col].coefficients[k]
+			}
+		}
+	}
+
+	res := make([]linear, lastCol)
+	for j := range res {
+		res[j] = linear{coefficients: make([]float64, n), rhs: rhs[j].rhs / a[j][j]}
+		for k, c := range rhs[j].coefficients {
+			res[j].coefficients[k] = c / a[j][j]
+		}
+	}
+
+	return res, nil
+}
+
+// fractionalRow returns the first row of the basic x which is not integer,
+// -1 when every one of them is.
+func fractionalRow(m matrix.Matrix) int {
+	lastCol := len(m.Rows[0]) - 1
+	for row, variable := range m.LeftTitle {
+		el := matrix.RoundTo(m.Rows[row][lastCol], 2)
 		if variable.IsX() && el != float64(int(el)) {
-			l := m.NegativeRowFor(optimal.Matrix.IntegerLimitationFor(row))
-			withLimit := optimal.Matrix.InsertRow(l)
-			return FindIntegerSolution(withLimit.Round())
+			return row
+		}
+	}
+
+	return -1
+}
+
+// gomoryCut takes the row x = b - sum(a_j*t_j) and returns the cut
+// sum({a_j}*t_j) >= {b}, where {} is the fractional part, with the
+// columns t_j written with the x variables.
+func gomoryCut(m matrix.Matrix, row int, slacks map[matrix.Variable]linear) (linear, error) {
+	n := m.GetXCount()
+	lastCol := len(m.Rows[0]) - 1
+
+	// the cut is sum(c_k*x_k) + constant >= {b}
+	c := make([]float64, n)
+	var constant float64
+	for j, variable := range m.TopTitle[:lastCol] {
+		f := fraction(m.Rows[row][j])
+		if f == 0 {
+			continue
+		}
+
+		if variable.IsX() {
+			c[variable.FirstStageIndex] += f
+			continue
 		}
+
+		slack, ok := slacks[variable]
+		if !ok {
+			return linear{}, fmt.Errorf("%w: %v", ErrUnknownConstraint, variable)
+		}
+
+		for k, el := range slack.coefficients {
+			c[k] -= f * el
+		}
+		constant += f * slack.rhs
+	}
+
+	for k := range c {
+		c[k] *= -1
+	}
+
+	return linear{coefficients: c, rhs: constant - fraction(m.Rows[row][lastCol])}, nil
+}
+
+func fraction(el float64) float64 {
+	f := el - math.Floor(el)
+	if f < eps || 1-f < eps {
+		return 0
 	}
 
-	return optimal, &support, nil
+	return f
 }
@@ -1,6 +1,8 @@
 package inequations
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -34,9 +36,9 @@ func TestFindIntegerSolution(t *testing.T) {
 			},
 			expected: matrix.Matrix{
 				Rows: []matrix.Row{
-					{5.06, -2, 3},
+					{5, -2, 3},
 					{1, 0, 1},
-					{-3.03, 1, 1},
+					{-3, 1, 1},
 					{1, 1, 5},
 				},
 				LeftTitle: []matrix.Variable{
@@ -46,7 +48,7 @@ func TestFindIntegerSolution(t *testing.T) {
 					{FirstStageName: "z"},
 				},
 				TopTitle: []matrix.Variable{
-					{FirstStageName: "s", FirstStageIndex: 0},
+					{FirstStageName: "y", FirstStageIndex: 2, SecondStageName: "u", SecondStageIndex: 2},
 					{FirstStageName: "y", FirstStageIndex: 1},
 					{FirstStageName: "1"},
 				},
@@ -76,24 +78,22 @@ func TestFindIntegerSolution(t *testing.T) {
 			},
 			expected: matrix.Matrix{
 				Rows: []matrix.Row{
-					{-0.18, 0.45, 0, 2},
-					{0.27, -0.18, 0, 2},
-					{-0.27, -0.82, 1, 1},
-					{-0.91, 0.27, 0, 1},
-					{-0.73, -0.18, 0, 0},
-					{0.36, 0.09, 1, 19},
+					{0.5, -0.25, 0, 2},
+					{-0.25, 0.37, 0, 2},
+					{-0.75, -0.37, 1, 1},
+					{0.5, -1.25, 0, 1},
+					{0, 0.5, 1, 19},
 				},
 				LeftTitle: []matrix.Variable{
 					{FirstStageName: "x", FirstStageIndex: 0},
 					{FirstStageName: "x", FirstStageIndex: 1},
 					{FirstStageName: "x", FirstStageIndex: 2},
 					{FirstStageName: "y", FirstStageIndex: 1},
-					{FirstStageName: "s"},
 					{FirstStageName: "z"},
 				},
 				TopTitle: []matrix.Variable{
-					{FirstStageName: "s"},
 					{FirstStageName: "y", FirstStageIndex: 0},
+					{FirstStageName: "y", FirstStageIndex: 3, SecondStageName: "u", SecondStageIndex: 3},
 					{FirstStageName: "y", FirstStageIndex: 2},
 					{FirstStageName: "1"},
 				},
@@ -104,7 +104,7 @@ func TestFindIntegerSolution(t *testing.T) {
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			actual, _, err := FindIntegerSolution(tt.m)
+			actual, _, err := FindIntegerSolution(context.Background(), tt.m)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -116,3 +116,80 @@ func TestFindIntegerSolution(t *testing.T) {
 		})
 	}
 }
+
+func TestFindIntegerSolutionCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	m := matrix.Matrix{
+		Rows: []matrix.Row{
+			{2, 1, 6},
+			{1, 3, 4},
+			{-1, -4, 0},
+		},
+		LeftTitle: []matrix.Variable{
+			{FirstStageName: "y", FirstStageIndex: 0},
+			{FirstStageName: "y", FirstStageIndex: 1},
+			{FirstStageName: "z"},
+		},
+		TopTitle: []matrix.Variable{
+			{FirstStageName: "x", FirstStageIndex: 0},
+			{FirstStageName: "x", FirstStageIndex: 1},
+			{FirstStageName: "1"},
+		},
+	}
+
+	if _, _, err := FindIntegerSolution(ctx, m); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get: %v, got: %v", context.Canceled, err)
+	}
+}
+
+func TestFindIntegerSolutionWithEquation(t *testing.T) {
+	tc := []struct {
+		name     string
+		rhs      float64
+		expected []float64
+		err      error
+	}{
+		{
+			name:     "Should cut tableau without some x columns",
+			rhs:      8,
+			expected: []float64{2, 2},
+		},
+		{
+			name: "Should report equation without integer solution",
+			rhs:  9,
+			err:  ErrInfeasible,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := matrix.Matrix{
+				Rows: []matrix.Row{
+					{2, 2, tt.rhs},
+					{2, -2, 3},
+					{-3, -2, 0},
+				},
+			}
+			m.FillLeftTitle()
+			m.FillTopTitle()
+			m.LeftTitle[0].FirstStageName = "0"
+
+			m, err := m.DeleteZeros()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual, _, err := FindIntegerSolution(context.Background(), m)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err == nil && !reflect.DeepEqual(actual.Result, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, actual.Result)
+			}
+		})
+	}
+}
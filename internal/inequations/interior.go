@@ -0,0 +1,576 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const CrossoverStage matrix.Stage = "crossover"
+
+const (
+	interiorTolerance     = 1e-9
+	maxInteriorIterations = 200
+	// interiorStepScale keeps the iterates strictly inside the orthant.
+	interiorStepScale = 0.99
+)
+
+var (
+	ErrNotConverged  = errors.New("interior point method didn't converge: problem may be infeasible or unbounded")
+	ErrDependentRows = errors.New("constraints are linearly dependent")
+)
+
+// standardForm is the tableau read as min c*x, A*x = b, x >= 0: the
+// nonbasic columns go first, then the slacks of the rows. Equations
+// ("0" rows) have no slack.
+type standardForm struct {
+	a      [][]float64
+	b      []float64
+	c      []float64
+	titles []matrix.Variable
+	// slackRow maps the slack columns to their rows.
+	slackRow map[int]int
+}
+
+func newStandardForm(m matrix.Matrix) standardForm {
+	lastRow, lastCol := len(m.Rows)-1, len(m.Rows[0])-1
+	f := standardForm{
+		a:        make([][]float64, lastRow),
+		b:        make([]float64, lastRow),
+		c:        slices.Clone(m.Rows[lastRow][:lastCol]),
+		titles:   slices.Clone(m.TopTitle[:lastCol]),
+		slackRow: make(map[int]int),
+	}
+
+	for i := range lastRow {
+		f.a[i] = slices.Clone(m.Rows[i][:lastCol])
+		f.b[i] = m.Rows[i][lastCol]
+	}
+
+	for i, title := range m.LeftTitle[:lastRow] {
+		if title.IsZero() {
+			continue
+		}
+
+		col := len(f.c)
+		f.slackRow[col] = i
+		f.c = append(f.c, 0)
+		f.titles = append(f.titles, title)
+		for r := range f.a {
+			el := 0.
+			if r == i {
+				el = 1
+			}
+			f.a[r] = append(f.a[r], el)
+		}
+	}
+
+	return f
+}
+
+// SolveInteriorMax maximizes the tableau with the Mehrotra predictor
+// corrector method. The crossover then moves the interior solution to
+// a basis, so Optimal holds the usual tableau with unrounded values and
+// Dual is read from it. Support is the interior solution itself.
+func SolveInteriorMax(ctx context.Context, m matrix.Matrix) (*Result, error) {
+	return solveInterior(ctx, m, 1)
+}
+
+func SolveInteriorMin(ctx context.Context, m matrix.Matrix) (*Result, error) {
+	return solveInterior(ctx, m, -1)
+}
+
+func solveInterior(ctx context.Context, m matrix.Matrix, sign float64) (*Result, error) {
+	if len(m.Rows) < 2 || len(m.Rows[0]) < 2 {
+		return nil, errors.New("tableau should have constraints, variables and the free terms")
+	}
+
+	m = m.Copy()
+	if len(m.LeftTitle) == 0 {
+		m.FillLeftTitle()
+	}
+	if len(m.TopTitle) == 0 {
+		m.FillTopTitle()
+	}
+	m.LeftTitle = slices.Clone(m.LeftTitle)
+	m.TopTitle = slices.Clone(m.TopTitle)
+
+	lastRow := len(m.Rows) - 1
+	if sign < 0 {
+		for i := range m.Rows[lastRow] {
+			m.Rows[lastRow][i] *= -1
+		}
+	}
+
+	f := newStandardForm(m)
+	x, err := f.solve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	support := Solution{Matrix: m.Copy(), Result: make([]float64, m.GetXCount())}
+	support.Matrix.LeftTitle = slices.Clone(m.LeftTitle)
+	support.Matrix.TopTitle = slices.Clone(m.TopTitle)
+	for j, title := range f.titles {
+		if title.IsX() {
+			support.Result[title.FirstStageIndex] = x[j]
+		}
+	}
+
+	optimal, err := crossover(ctx, m, f, x)
+	if err != nil {
+		return nil, err
+	}
+
+	lastCol := len(optimal.Rows[0]) - 1
+	return &Result{
+		Support:   support,
+		Optimal:   Solution{Matrix: optimal, Result: xValues(optimal)},
+		Objective: sign * optimal.Rows[len(optimal.Rows)-1][lastCol],
+		Dual:      dualResult(optimal),
+	}, nil
+}
+
+// solve runs the predictor-corrector iterations and returns the primal
+// solution.
+func (f standardForm) solve(ctx context.Context) ([]float64, error) {
+	x, y, s := f.start()
+	n := len(x)
+	bNorm, cNorm := 1+norm(f.b), 1+norm(f.c)
+
+	for range maxInteriorIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rb := f.residualB(x)
+		rc := f.residualC(y, s)
+		mu := dot(x, s) / float64(n)
+		gap := mu / (1 + math.Abs(dot(f.c, x)))
+		if norm(rb)/bNorm < interiorTolerance && norm(rc)/cNorm < interiorTolerance && gap < interiorTolerance {
+			return x, nil
+		}
+
+		normal, err := f.normal(x, s)
+		if err != nil {
+			return nil, err
+		}
+
+		rxs := make([]float64, n)
+		for i := range rxs {
+			rxs[i] = -x[i] * s[i]
+		}
+
+		dx, dy, ds := f.direction(normal, x, s, rb, rc, rxs)
+		alphaP, alphaD := min(1, maxStep(x, dx)), min(1, maxStep(s, ds))
+
+		var affine float64
+		for i := range x {
+			affine += (x[i] + alphaP*dx[i]) * (s[i] + alphaD*ds[i])
+		}
+		sigma := math.Pow(affine/float64(n)/mu, 3)
+
+		for i := range rxs {
+			rxs[i] += -dx[i]*ds[i] + sigma*mu
+		}
+
+		dx, dy, ds = f.direction(normal, x, s, rb, rc, rxs)
+		alphaP = min(1, interiorStepScale*maxStep(x, dx))
+		alphaD = min(1, interiorStepScale*maxStep(s, ds))
+
+		for i := range x {
+			x[i] += alphaP * dx[i]
+			s[i] += alphaD * ds[i]
+		}
+
+		for i := range y {
+			y[i] += alphaD * dy[i]
+		}
+
+		if norm(x) > 1/interiorTolerance || norm(y) > 1/interiorTolerance {
+			return nil, ErrNotConverged
+		}
+	}
+
+	return nil, ErrNotConverged
+}
+
+// start is the Mehrotra heuristic: the least squares solutions shifted
+// into the positive orthant.
+func (f standardForm) start() (x, y, s []float64) {
+	aat := f.product(nil)
+	x = f.transposed(solveSymmetric(aat, f.b))
+	y = solveSymmetric(aat, f.multiply(f.c))
+	s = make([]float64, len(f.c))
+	aty := f.transposed(y)
+	for i := range s {
+		s[i] = f.c[i] - aty[i]
+	}
+
+	dx := max(-1.5*slices.Min(x), 0)
+	ds := max(-1.5*slices.Min(s), 0)
+	for i := range x {
+		x[i] += dx
+		s[i] += ds
+	}
+
+	xs := dot(x, s)
+	sx, ss := sum(x), sum(s)
+	for i := range x {
+		x[i] += 0.5 * xs / max(ss, interiorTolerance)
+		s[i] += 0.5 * xs / max(sx, interiorTolerance)
+		x[i], s[i] = max(x[i], interiorTolerance), max(s[i], interiorTolerance)
+	}
+
+	return x, y, s
+}
+
+// direction solves the Newton system for the complementarity target rxs
+// through the normal equations A*D*At*dy = -rb - A*(rxs/s) - A*D*rc.
+func (f standardForm) direction(normal [][]float64, x, s, rb, rc, rxs []float64) (dx, dy, ds []float64) {
+	n := len(x)
+	t := make([]float64, n)
+	for i := range t {
+		t[i] = rxs[i]/s[i] + x[i]/s[i]*rc[i]
+	}
+
+	r := f.multiply(t)
+	for i := range r {
+		r[i] = -rb[i] - r[i]
+	}
+
+	dy = solveSymmetric(normal, r)
+	aty := f.transposed(dy)
+	dx, ds = make([]float64, n), make([]float64, n)
+	for i := range ds {
+		ds[i] = -rc[i] - aty[i]
+		dx[i] = (rxs[i] - x[i]*ds[i]) / s[i]
+	}
+
+	return dx, dy, ds
+}
+
+func (f standardForm) normal(x, s []float64) ([][]float64, error) {
+	d := make([]float64, len(x))
+	for i := range d {
+		d[i] = x[i] / s[i]
+		if math.IsNaN(d[i]) || math.IsInf(d[i], 0) {
+			return nil, ErrNotConverged
+		}
+	}
+
+	return f.product(d), nil
+}
+
+// product returns A*D*At, nil d stands for the identity.
+func (f standardForm) product(d []float64) [][]float64 {
+	res := make([][]float64, len(f.a))
+	for i := range res {
+		res[i] = make([]float64, len(f.a))
+		for j := range res[i] {
+			for k := range f.a[i] {
+				w := 1.
+				if d != nil {
+					w = d[k]
+				}
+				res[i][j] += f.a[i][k] * w * f.a[j][k]
+			}
+		}
+	}
+
+	return res
+}
+
+func (f standardForm) multiply(v []float64) []float64 {
+	res := make([]float64, len(f.a))
+	for i, row := range f.a {
+		res[i] = dot(row, v)
+	}
+
+	return res
+}
+
+func (f standardForm) transposed(v []float64) []float64 {
+	res := make([]float64, len(f.c))
+	for i, row := range f.a {
+		for j, el := range row {
+			res[j] += el * v[i]
+		}
+	}
+
+	return res
+}
+
+func (f standardForm) residualB(x []float64) []float64 {
+	res := f.multiply(x)
+	for i := range res {
+		res[i] -= f.b[i]
+	}
+
+	return res
+}
+
+func (f standardForm) residualC(y, s []float64) []float64 {
+	res := f.transposed(y)
+	for i := range res {
+		res[i] += s[i] - f.c[i]
+	}
+
+	return res
+}
+
+// crossover picks the basis among the largest interior values, pivots
+// it into the tableau and lets the simplex fix what the rounding of the
+// interior solution left.
+func crossover(ctx context.Context, m matrix.Matrix, f standardForm, x []float64) (matrix.Matrix, error) {
+	basis, err := f.basis(x)
+	if err != nil {
+		return matrix.Matrix{}, err
+	}
+
+	chosen := make(map[matrix.Variable]bool, len(basis))
+	for _, j := range basis {
+		chosen[f.titles[j]] = true
+	}
+
+	m, err = pivotBasis(ctx, m, chosen, len(m.Rows)-1, CrossoverStage)
+	if err != nil {
+		return matrix.Matrix{}, err
+	}
+
+	for _, row := range m.Rows {
+		for j, el := range row {
+			if math.Abs(el) < interiorTolerance {
+				row[j] = 0
+			}
+		}
+	}
+
+	if _, err := m.FirstNegativeRowInLastColumn(); err == nil {
+		matrix.Printf(ctx, "\nFinding the optimal solution...\n")
+		if _, err := m.FirstNegativeColumnInLastRow(); err != nil {
+			dual, err := FindDualSolution(ctx, m)
+			if err != nil {
+				return matrix.Matrix{}, err
+			}
+			return dual.Matrix, nil
+		}
+
+		support, err := FindSupportSolution(ctx, m)
+		if err != nil {
+			return matrix.Matrix{}, err
+		}
+		m = support.Matrix
+	}
+
+	optimal, err := FindOptimalSolution(ctx, m)
+	if err != nil {
+		return matrix.Matrix{}, err
+	}
+
+	return optimal.Matrix, nil
+}
+
+// pivotBasis moves the chosen variables to the left title, the row for
+// every one of them is the biggest element among the first rows whose
+// variable isn't chosen.
+func pivotBasis(
+	ctx context.Context,
+	m matrix.Matrix,
+	chosen map[matrix.Variable]bool,
+	rows int,
+	stage matrix.Stage,
+) (matrix.Matrix, error) {
+	lastCol := len(m.Rows[0]) - 1
+	for col := 0; col < lastCol; col++ {
+		if !chosen[m.TopTitle[col]] {
+			continue
+		}
+
+		row := -1
+		for i := range rows {
+			if chosen[m.LeftTitle[i]] {
+				continue
+			}
+
+			if row == -1 || math.Abs(m.Rows[i][col]) > math.Abs(m.Rows[row][col]) {
+				row = i
+			}
+		}
+
+		if row == -1 || math.Abs(m.Rows[row][col]) < interiorTolerance {
+			return matrix.Matrix{}, ErrDependentRows
+		}
+
+		before := matrix.PivotSnapshot(ctx, m)
+		next, err := m.JordanEliminateModified(col, row)
+		if err != nil {
+			return matrix.Matrix{}, err
+		}
+
+		m = next
+		matrix.NotifyPivot(ctx, stage, row, col, before, m)
+		matrix.PrintStep(ctx, m, "\nElement col: %v row: %v, Matrix:\n\n", col, row)
+	}
+
+	return m, nil
+}
+
+// basis takes the linearly independent columns with the largest values.
+func (f standardForm) basis(x []float64) ([]int, error) {
+	order := make([]int, len(x))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		switch {
+		case x[i] > x[j]:
+			return -1
+		case x[i] < x[j]:
+			return 1
+		}
+		return 0
+	})
+
+	rows := len(f.a)
+	var (
+		basis   []int
+		reduced [][]float64
+		pivots  []int
+	)
+	for _, j := range order {
+		if len(basis) == rows {
+			break
+		}
+
+		v := make([]float64, rows)
+		for i := range v {
+			v[i] = f.a[i][j]
+		}
+
+		for k, r := range reduced {
+			ratio := v[pivots[k]] / r[pivots[k]]
+			for i := range v {
+				v[i] -= ratio * r[i]
+			}
+		}
+
+		p := 0
+		for i := range v {
+			if math.Abs(v[i]) > math.Abs(v[p]) {
+				p = i
+			}
+		}
+
+		if math.Abs(v[p]) < interiorTolerance {
+			continue
+		}
+
+		basis = append(basis, j)
+		reduced = append(reduced, v)
+		pivots = append(pivots, p)
+	}
+
+	if len(basis) < rows {
+		return nil, ErrDependentRows
+	}
+
+	return basis, nil
+}
+
+// solveSymmetric solves the positive definite system with the Cholesky
+// decomposition, a tiny regularization keeps it working near the end
+// where the system gets ill-conditioned.
+func solveSymmetric(a [][]float64, b []float64) []float64 {
+	n := len(b)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+
+	for i := range n {
+		for j := 0; j <= i; j++ {
+			sum := a[i][j]
+			for k := range j {
+				sum -= l[i][k] * l[j][k]
+			}
+
+			if i == j {
+				l[i][i] = math.Sqrt(max(sum, interiorTolerance*interiorTolerance) + interiorTolerance*interiorTolerance)
+				continue
+			}
+
+			l[i][j] = sum / l[j][j]
+		}
+	}
+
+	y := make([]float64, n)
+	for i := range n {
+		sum := b[i]
+		for k := range i {
+			sum -= l[i][k] * y[k]
+		}
+		y[i] = sum / l[i][i]
+	}
+
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := y[i]
+		for k := i + 1; k < n; k++ {
+			sum -= l[k][i] * x[k]
+		}
+		x[i] = sum / l[i][i]
+	}
+
+	return x
+}
+
+// maxStep is the longest step along d keeping v non-negative.
+func maxStep(v, d []float64) float64 {
+	step := math.Inf(1)
+	for i := range v {
+		if d[i] < 0 {
+			step = min(step, -v[i]/d[i])
+		}
+	}
+
+	return min(step, 1/interiorTolerance)
+}
+
+// xValues reads the x variables from the tableau without rounding.
+func xValues(m matrix.Matrix) []float64 {
+	lastCol := len(m.Rows[0]) - 1
+	res := make([]float64, m.GetXCount())
+	for i, title := range m.LeftTitle {
+		if title.IsX() {
+			res[title.FirstStageIndex] = m.Rows[i][lastCol]
+		}
+	}
+
+	return res
+}
+
+func dot(a, b []float64) float64 {
+	var res float64
+	for i := range a {
+		res += a[i] * b[i]
+	}
+
+	return res
+}
+
+func sum(v []float64) float64 {
+	var res float64
+	for _, el := range v {
+		res += el
+	}
+
+	return res
+}
+
+func norm(v []float64) float64 {
+	return math.Sqrt(dot(v, v))
+}
@@ -0,0 +1,164 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestSolveInterior(t *testing.T) {
+	equation := tableau(matrix.Row{1, 1, 4}, matrix.Row{1, 0, 3}, matrix.Row{-1, -2, 0})
+	equation.LeftTitle[0] = matrix.Variable{FirstStageName: "0", SecondStageName: "u"}
+
+	tc := []struct {
+		name      string
+		m         matrix.Matrix
+		minimize  bool
+		optimal   []float64
+		objective float64
+		dual      []float64
+		err       error
+	}{
+		{
+			name:      "Should not round solution",
+			m:         tableau(matrix.Row{1, 2, 1}, matrix.Row{2, 1, 1}, matrix.Row{-1, -1, 0}),
+			optimal:   []float64{1. / 3, 1. / 3},
+			objective: 2. / 3,
+			dual:      []float64{1. / 3, 1. / 3, 0},
+		},
+		{
+			name:      "Should solve min problem",
+			m:         tableau(matrix.Row{-1, -1, -4}, matrix.Row{1, -1, 2}, matrix.Row{-3, -2, 0}),
+			minimize:  true,
+			optimal:   []float64{0, 4},
+			objective: 8,
+			dual:      []float64{2, 0, 0},
+		},
+		{
+			name:      "Should solve equation without deleting zeros",
+			m:         equation,
+			optimal:   []float64{0, 4},
+			objective: 8,
+		},
+		{
+			name: "Should not converge for infeasible problem",
+			m:    tableau(matrix.Row{1, 1, -1}, matrix.Row{-1, -1, 0}),
+			err:  ErrNotConverged,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			solve := SolveInteriorMax
+			if tt.minimize {
+				solve = SolveInteriorMin
+			}
+
+			got, err := solve(context.Background(), tt.m)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !near(got.Optimal.Result, tt.optimal) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.optimal, got.Optimal.Result)
+			}
+
+			if !near(got.Support.Result, tt.optimal) {
+				t.Fatalf("Expected interior solution to be near: %v, got: %v", tt.optimal, got.Support.Result)
+			}
+
+			if math.Abs(got.Objective-tt.objective) > 1e-9 {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, got.Objective)
+			}
+
+			if tt.dual != nil && !near(got.Dual, tt.dual) {
+				t.Fatalf("Expected to get dual: %v, got: %v", tt.dual, got.Dual)
+			}
+		})
+	}
+}
+
+func TestSolveInteriorMatchesSimplex(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(1))
+	for i := range 30 {
+		rows, cols := 2+r.Intn(5), 2+r.Intn(5)
+		m := make([]matrix.Row, 0, rows+1)
+		for range rows {
+			row := make(matrix.Row, cols+1)
+			for j := range cols {
+				row[j] = float64(r.Intn(10))
+			}
+			row[cols] = float64(1 + r.Intn(20))
+			m = append(m, row)
+		}
+
+		z := make(matrix.Row, cols+1)
+		for j := range cols {
+			z[j] = -float64(1 + r.Intn(5))
+		}
+
+		// Columns of zeros make the problem unbounded.
+		for j := range cols {
+			m[r.Intn(rows)][j] += 1
+		}
+
+		expected, err := SolveMax(context.Background(), tableau(append(m, z)...), SimplexMethod)
+		if err != nil {
+			t.Fatalf("%d: Expected to get no error, got: %v", i, err)
+		}
+
+		got, err := SolveMax(context.Background(), tableau(append(m, z)...), InteriorPointMethod)
+		if err != nil {
+			t.Fatalf("%d: Expected to get no error, got: %v", i, err)
+		}
+
+		if math.Abs(got.Objective-expected.Objective) > 1e-6 {
+			t.Fatalf("%d: Expected to get: %v, got: %v", i, expected.Objective, got.Objective)
+		}
+
+		for k, row := range m {
+			var lhs float64
+			for j := range cols {
+				lhs += row[j] * got.Optimal.Result[j]
+			}
+
+			if lhs > row[cols]+1e-9 {
+				t.Fatalf("%d: Expected row %d to hold, got: %v > %v", i, k, lhs, row[cols])
+			}
+		}
+	}
+}
+
+func TestSolveInteriorCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	m := tableau(matrix.Row{1, 1, 4}, matrix.Row{-3, -2, 0})
+	if _, err := SolveInteriorMax(ctx, m); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get: %v, got: %v", context.Canceled, err)
+	}
+}
+
+func near(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > 1e-6 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,133 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+type Method uint8
+
+const (
+	SimplexMethod Method = iota
+	IntegerMethod
+	DoubledMethod
+	BoundedMethod
+	InteriorPointMethod
+)
+
+var ErrUnknownMethod = errors.New("unknown solving method")
+
+// Result is the common outcome of SolveMax and SolveMin. Dual is only
+// filled by DoubledMethod and InteriorPointMethod. With BoundedMethod
+// they pass nil bounds, so every x is non-negative, SolveBoundedMax and
+// SolveBoundedMin take the other bounds.
+type Result struct {
+	Support   Solution
+	Optimal   Solution
+	Objective float64
+	Dual      []float64
+}
+
+func SolveMax(ctx context.Context, m matrix.Matrix, method Method) (*Result, error) {
+	switch method {
+	case BoundedMethod:
+		return SolveBoundedMax(ctx, m, nil)
+
+	case InteriorPointMethod:
+		return SolveInteriorMax(ctx, m)
+
+	case IntegerMethod:
+		optimal, support, err := FindMaxIntegerSolution(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Result{Support: *support, Optimal: optimal.Solution, Objective: optimal.Max}, nil
+
+	case DoubledMethod:
+		support, err := FindSupportSolution(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		optimal, err := FindMaxDoubledWithOptimalSolution(ctx, support.Matrix)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Result{
+			Support:   support,
+			Optimal:   optimal.MaxSolution.Solution,
+			Objective: optimal.Max,
+			Dual:      optimal.MinSolution.Result,
+		}, nil
+
+	case SimplexMethod:
+		support, err := FindSupportSolution(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		optimal, err := FindMaxWithOptimalSolution(ctx, support.Matrix)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Result{Support: support, Optimal: optimal.Solution, Objective: optimal.Max}, nil
+	}
+
+	return nil, ErrUnknownMethod
+}
+
+func SolveMin(ctx context.Context, m matrix.Matrix, method Method) (*Result, error) {
+	switch method {
+	case BoundedMethod:
+		return SolveBoundedMin(ctx, m, nil)
+
+	case InteriorPointMethod:
+		return SolveInteriorMin(ctx, m)
+
+	case IntegerMethod:
+		optimal, support, err := FindMinIntegerSolution(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Result{Support: *support, Optimal: optimal.Solution, Objective: optimal.Min}, nil
+
+	case DoubledMethod:
+		support, err := FindMinWithSupportSolution(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		optimal, err := FindMinDoubledWithOptimalSolution(ctx, support.Matrix)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Result{
+			Support:   support,
+			Optimal:   optimal.MinSolution.Solution,
+			Objective: optimal.Min,
+			Dual:      optimal.MaxSolution.Result,
+		}, nil
+
+	case SimplexMethod:
+		support, err := FindMinWithSupportSolution(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		optimal, err := FindMinWithOptimalSolution(ctx, support.Matrix)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Result{Support: support, Optimal: optimal.Solution, Objective: optimal.Min}, nil
+	}
+
+	return nil, ErrUnknownMethod
+}
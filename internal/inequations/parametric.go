@@ -0,0 +1,361 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const ParametricStage matrix.Stage = "parametric"
+
+// maxParametricIntervals stops the degenerate breakpoints from cycling
+// forever.
+const maxParametricIntervals = 10000
+
+var (
+	ErrInvalidInterval  = errors.New("parameter should go from a finite value up to a bigger one")
+	ErrInvalidDirection = errors.New("direction should have a value per constraint or variable of the tableau")
+	ErrEquations        = errors.New("parametric tableau should have no equations")
+)
+
+// parameter is the title of the direction column or row.
+var parameter = matrix.Variable{FirstStageName: "t"}
+
+// Affine is the value Constant + Slope*t.
+type Affine struct {
+	Constant float64
+	Slope    float64
+}
+
+func (a Affine) At(t float64) float64 {
+	return a.Constant + a.Slope*t
+}
+
+// ParametricInterval is the range of the parameter where the optimal
+// basis stays the same. Basis is the left title of the optimal tableau
+// without the last row, Solution holds the x variables.
+type ParametricInterval struct {
+	From      float64
+	To        float64
+	Basis     []matrix.Variable
+	Solution  []Affine
+	Objective Affine
+}
+
+// ParametricRHSMax maximizes the tableau with the free terms
+// b + t*direction for every t in [from; to]. The direction has a value
+// per constraint, the intervals end early if the problem gets infeasible.
+func ParametricRHSMax(
+	ctx context.Context,
+	m matrix.Matrix,
+	direction []float64,
+	from, to float64,
+) ([]ParametricInterval, error) {
+	return parametric(ctx, m, direction, nil, from, to, 1)
+}
+
+func ParametricRHSMin(
+	ctx context.Context,
+	m matrix.Matrix,
+	direction []float64,
+	from, to float64,
+) ([]ParametricInterval, error) {
+	return parametric(ctx, m, direction, nil, from, to, -1)
+}
+
+// ParametricObjectiveMax maximizes the tableau with the objective
+// c + t*direction for every t in [from; to]. The direction has a value
+// per column, the intervals end early if the problem gets unbounded.
+func ParametricObjectiveMax(
+	ctx context.Context,
+	m matrix.Matrix,
+	direction []float64,
+	from, to float64,
+) ([]ParametricInterval, error) {
+	return parametric(ctx, m, nil, direction, from, to, 1)
+}
+
+func ParametricObjectiveMin(
+	ctx context.Context,
+	m matrix.Matrix,
+	direction []float64,
+	from, to float64,
+) ([]ParametricInterval, error) {
+	return parametric(ctx, m, nil, direction, from, to, -1)
+}
+
+// parametricTableau has either the direction column right before the
+// free terms or the direction row right after the last one.
+type parametricTableau struct {
+	m      matrix.Matrix
+	rows   int
+	rhs    bool
+	sign   float64
+	xCount int
+}
+
+func parametric(
+	ctx context.Context,
+	m matrix.Matrix,
+	rhs, objective []float64,
+	from, to float64,
+	sign float64,
+) ([]ParametricInterval, error) {
+	if len(m.Rows) < 2 || len(m.Rows[0]) < 2 {
+		return nil, errors.New("tableau should have constraints, variables and the free terms")
+	}
+
+	if math.IsInf(from, 0) || math.IsNaN(from) || math.IsNaN(to) || from > to {
+		return nil, ErrInvalidInterval
+	}
+
+	rows, cols := len(m.Rows)-1, len(m.Rows[0])-1
+	if (rhs != nil && len(rhs) != rows) || (objective != nil && len(objective) != cols) {
+		return nil, ErrInvalidDirection
+	}
+
+	m = m.Copy()
+	if len(m.LeftTitle) == 0 {
+		m.FillLeftTitle()
+	}
+	if len(m.TopTitle) == 0 {
+		m.FillTopTitle()
+	}
+	m.LeftTitle = slices.Clone(m.LeftTitle)
+	m.TopTitle = slices.Clone(m.TopTitle)
+
+	if slices.ContainsFunc(m.LeftTitle, matrix.Variable.IsZero) {
+		return nil, ErrEquations
+	}
+
+	basis, err := startingBasis(ctx, m, rhs, objective, from, sign)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parametricTableau{m: m, rows: rows, rhs: rhs != nil, sign: sign, xCount: m.GetXCount()}
+	if p.rhs {
+		p.withColumn(rhs)
+	} else {
+		p.withRow(objective)
+	}
+
+	if p.m, err = pivotBasis(ctx, p.m, basis, rows, ParametricStage); err != nil {
+		return nil, err
+	}
+
+	return p.walk(ctx, from, to)
+}
+
+// startingBasis solves the tableau for the first parameter value and
+// returns its optimal basis.
+func startingBasis(
+	ctx context.Context,
+	m matrix.Matrix,
+	rhs, objective []float64,
+	t float64,
+	sign float64,
+) (map[matrix.Variable]bool, error) {
+	start := m.Copy()
+	start.LeftTitle = slices.Clone(m.LeftTitle)
+	start.TopTitle = slices.Clone(m.TopTitle)
+
+	lastRow, lastCol := len(m.Rows)-1, len(m.Rows[0])-1
+	for i, d := range rhs {
+		start.Rows[i][lastCol] += t * d
+	}
+	for j, d := range objective {
+		start.Rows[lastRow][j] -= t * d
+	}
+
+	solve := SolveMax
+	if sign < 0 {
+		solve = SolveMin
+	}
+
+	res, err := solve(ctx, start, SimplexMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	optimal := res.Optimal.Matrix
+	basis := make(map[matrix.Variable]bool, len(optimal.LeftTitle))
+	for _, v := range optimal.LeftTitle[:len(optimal.LeftTitle)-1] {
+		basis[v] = true
+	}
+
+	return basis, nil
+}
+
+// withColumn inserts the direction of the free terms before them.
+func (p *parametricTableau) withColumn(direction []float64) {
+	lastRow := len(p.m.Rows) - 1
+	for i, row := range p.m.Rows {
+		var d float64
+		if i < lastRow {
+			d = direction[i]
+		}
+		p.m.Rows[i] = slices.Insert(row, len(row)-1, d)
+	}
+
+	if p.sign < 0 {
+		for j := range p.m.Rows[lastRow] {
+			p.m.Rows[lastRow][j] *= -1
+		}
+	}
+
+	p.m.TopTitle = slices.Insert(p.m.TopTitle, len(p.m.TopTitle)-1, parameter)
+}
+
+// withRow appends the direction of the objective in the form of the last
+// row, so the objective is the last row plus t times the direction row.
+func (p *parametricTableau) withRow(direction []float64) {
+	row := make(matrix.Row, len(p.m.Rows[0]))
+	for j, d := range direction {
+		row[j] = -d
+	}
+
+	p.m.Rows = append(p.m.Rows, row)
+	p.m.LeftTitle = append(p.m.LeftTitle, parameter)
+	if p.sign < 0 {
+		for _, i := range []int{p.rows, p.rows + 1} {
+			for j := range p.m.Rows[i] {
+				p.m.Rows[i][j] *= -1
+			}
+		}
+	}
+}
+
+// walk moves along the parameter: it finds where the current basis
+// stops being optimal and pivots to the next one, the dual simplex step
+// for the free terms and the primal one for the objective.
+func (p *parametricTableau) walk(ctx context.Context, t, to float64) ([]ParametricInterval, error) {
+	var res []ParametricInterval
+	for range maxParametricIntervals {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		end, row, col := p.breakpoint(t)
+		end = min(end, to)
+		last := end >= to || row == -1 || col == -1
+		if end-t > eps || (last && len(res) == 0) {
+			res = append(res, p.interval(t, end))
+		}
+
+		if last {
+			return res, nil
+		}
+
+		before := matrix.PivotSnapshot(ctx, p.m)
+		next, err := p.m.JordanEliminateModified(col, row)
+		if err != nil {
+			return nil, err
+		}
+
+		p.m = next
+		matrix.NotifyPivot(ctx, ParametricStage, row, col, before, p.m)
+		matrix.PrintStep(ctx, p.m, "\nt = %v, element col: %v row: %v, Matrix:\n\n", matrix.RoundTo(end, 2), col, row)
+		t = end
+	}
+
+	return nil, ErrCycling
+}
+
+// breakpoint returns the last parameter value the basis is optimal for
+// and the pivot leading past it, -1 if there's no basis past it.
+func (p *parametricTableau) breakpoint(t float64) (end float64, row, col int) {
+	end, row, col = math.Inf(1), -1, -1
+	lastCol := len(p.m.Rows[0]) - 1
+	z := p.m.Rows[p.rows]
+	if p.rhs {
+		for i := range p.rows {
+			d := p.m.Rows[i][lastCol-1]
+			if d >= -eps {
+				continue
+			}
+
+			if r := -p.m.Rows[i][lastCol] / d; r < end {
+				end, row = r, i
+			}
+		}
+
+		if row == -1 {
+			return end, row, col
+		}
+
+		for j, el := range p.m.Rows[row][:lastCol-1] {
+			if el >= -eps {
+				continue
+			}
+
+			if col == -1 || z[j]/-el < z[col]/-p.m.Rows[row][col] {
+				col = j
+			}
+		}
+
+		return max(end, t), row, col
+	}
+
+	w := p.m.Rows[p.rows+1]
+	for j, el := range w[:lastCol] {
+		if el >= -eps {
+			continue
+		}
+
+		if r := -z[j] / el; r < end {
+			end, col = r, j
+		}
+	}
+
+	if col == -1 {
+		return end, row, col
+	}
+
+	for i := range p.rows {
+		el := p.m.Rows[i][col]
+		if el <= eps {
+			continue
+		}
+
+		if row == -1 || p.m.Rows[i][lastCol]/el < p.m.Rows[row][lastCol]/p.m.Rows[row][col] {
+			row = i
+		}
+	}
+
+	return max(end, t), row, col
+}
+
+func (p *parametricTableau) interval(from, to float64) ParametricInterval {
+	lastCol := len(p.m.Rows[0]) - 1
+	res := ParametricInterval{
+		From:     from,
+		To:       to,
+		Basis:    slices.Clone(p.m.LeftTitle[:p.rows]),
+		Solution: make([]Affine, p.xCount),
+	}
+
+	for i, variable := range p.m.LeftTitle[:p.rows] {
+		if !variable.IsX() {
+			continue
+		}
+
+		res.Solution[variable.FirstStageIndex].Constant = p.m.Rows[i][lastCol]
+		if p.rhs {
+			res.Solution[variable.FirstStageIndex].Slope = p.m.Rows[i][lastCol-1]
+		}
+	}
+
+	z := p.m.Rows[p.rows]
+	res.Objective = Affine{Constant: p.sign * z[lastCol]}
+	if p.rhs {
+		res.Objective.Slope = p.sign * z[lastCol-1]
+	} else {
+		res.Objective.Slope = p.sign * p.m.Rows[p.rows+1][lastCol]
+	}
+
+	return res
+}
@@ -0,0 +1,292 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestParametricRHS(t *testing.T) {
+	tc := []struct {
+		name      string
+		m         matrix.Matrix
+		direction []float64
+		from      float64
+		to        float64
+		min       bool
+		expected  []ParametricInterval
+		err       error
+	}{
+		{
+			name: "Should split budget into intervals",
+			m: tableau(
+				matrix.Row{1, 1, 4},
+				matrix.Row{1, 0, 3},
+				matrix.Row{0, 1, 5},
+				matrix.Row{-3, -2, 0},
+			),
+			direction: []float64{1, 0, 0},
+			from:      -3,
+			to:        10,
+			expected: []ParametricInterval{
+				{From: -3, To: -1, Solution: []Affine{{4, 1}, {0, 0}}, Objective: Affine{12, 3}},
+				{From: -1, To: 4, Solution: []Affine{{3, 0}, {1, 1}}, Objective: Affine{11, 2}},
+				{From: 4, To: 10, Solution: []Affine{{3, 0}, {5, 0}}, Objective: Affine{19, 0}},
+			},
+		},
+		{
+			name: "Should stop where problem gets infeasible",
+			m: tableau(
+				matrix.Row{1, 1, 4},
+				matrix.Row{1, 0, 3},
+				matrix.Row{0, 1, 5},
+				matrix.Row{-3, -2, 0},
+			),
+			direction: []float64{-1, 0, 0},
+			from:      0,
+			to:        10,
+			expected: []ParametricInterval{
+				{From: 0, To: 1, Solution: []Affine{{3, 0}, {1, -1}}, Objective: Affine{11, -2}},
+				{From: 1, To: 4, Solution: []Affine{{4, -1}, {0, 0}}, Objective: Affine{12, -3}},
+			},
+		},
+		{
+			name: "Should minimize with growing demand",
+			m: tableau(
+				matrix.Row{-1, -1, -2},
+				matrix.Row{-2, -1, 0},
+			),
+			direction: []float64{-1},
+			from:      0,
+			to:        3,
+			min:       true,
+			expected: []ParametricInterval{
+				{From: 0, To: 3, Solution: []Affine{{0, 0}, {2, 1}}, Objective: Affine{2, 1}},
+			},
+		},
+		{
+			name: "Should solve single point",
+			m: tableau(
+				matrix.Row{1, 1, 4},
+				matrix.Row{-1, -1, 0},
+			),
+			direction: []float64{1},
+			from:      2,
+			to:        2,
+			expected: []ParametricInterval{
+				{From: 2, To: 2, Solution: []Affine{{4, 1}, {0, 0}}, Objective: Affine{4, 1}},
+			},
+		},
+		{
+			name: "Should reject reversed interval",
+			m: tableau(
+				matrix.Row{1, 1, 4},
+				matrix.Row{-1, -1, 0},
+			),
+			direction: []float64{1},
+			from:      2,
+			to:        1,
+			err:       ErrInvalidInterval,
+		},
+		{
+			name: "Should reject direction of wrong size",
+			m: tableau(
+				matrix.Row{1, 1, 4},
+				matrix.Row{-1, -1, 0},
+			),
+			direction: []float64{1, 2},
+			to:        1,
+			err:       ErrInvalidDirection,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			solve := ParametricRHSMax
+			if tt.min {
+				solve = ParametricRHSMin
+			}
+
+			got, err := solve(context.Background(), tt.m, tt.direction, tt.from, tt.to)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			checkIntervals(t, got, tt.expected, len(tt.m.Rows)-1)
+		})
+	}
+}
+
+func TestParametricObjective(t *testing.T) {
+	tc := []struct {
+		name      string
+		m         matrix.Matrix
+		direction []float64
+		from      float64
+		to        float64
+		min       bool
+		expected  []ParametricInterval
+		err       error
+	}{
+		{
+			name: "Should move along the vertices",
+			m: tableau(
+				matrix.Row{1, 1, 4},
+				matrix.Row{1, 0, 3},
+				matrix.Row{0, 1, 3},
+				matrix.Row{-1, -1, 0},
+			),
+			direction: []float64{1, 0},
+			from:      -2,
+			to:        5,
+			expected: []ParametricInterval{
+				{From: -2, To: -1, Solution: []Affine{{0, 0}, {3, 0}}, Objective: Affine{3, 0}},
+				{From: -1, To: 0, Solution: []Affine{{1, 0}, {3, 0}}, Objective: Affine{4, 1}},
+				{From: 0, To: 5, Solution: []Affine{{3, 0}, {1, 0}}, Objective: Affine{4, 3}},
+			},
+		},
+		{
+			name: "Should stop where problem gets unbounded",
+			m: tableau(
+				matrix.Row{1, 0, 2},
+				matrix.Row{-1, 0, 0},
+			),
+			direction: []float64{0, 1},
+			from:      -1,
+			to:        3,
+			expected: []ParametricInterval{
+				{From: -1, To: 0, Solution: []Affine{{2, 0}, {0, 0}}, Objective: Affine{2, 0}},
+			},
+		},
+		{
+			name: "Should minimize with growing price",
+			m: tableau(
+				matrix.Row{-1, -1, -2},
+				matrix.Row{-1, -2, 0},
+			),
+			direction: []float64{0, -1},
+			from:      0,
+			to:        2,
+			min:       true,
+			expected: []ParametricInterval{
+				{From: 0, To: 1, Solution: []Affine{{2, 0}, {0, 0}}, Objective: Affine{2, 0}},
+				{From: 1, To: 2, Solution: []Affine{{0, 0}, {2, 0}}, Objective: Affine{4, -2}},
+			},
+		},
+		{
+			name: "Should reject equations",
+			m: matrix.Matrix{
+				Rows:      []matrix.Row{{1, 1, 4}, {-1, -1, 0}},
+				LeftTitle: []matrix.Variable{{FirstStageName: "0"}, {FirstStageName: "z"}},
+			},
+			direction: []float64{1, 0},
+			to:        1,
+			err:       ErrEquations,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			solve := ParametricObjectiveMax
+			if tt.min {
+				solve = ParametricObjectiveMin
+			}
+
+			got, err := solve(context.Background(), tt.m, tt.direction, tt.from, tt.to)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			checkIntervals(t, got, tt.expected, len(tt.m.Rows)-1)
+		})
+	}
+}
+
+func TestParametricMatchesSimplex(t *testing.T) {
+	m := tableau(
+		matrix.Row{2, 1, 1, 10},
+		matrix.Row{1, 3, 2, 15},
+		matrix.Row{1, 1, 3, 12},
+		matrix.Row{-4, -5, -3, 0},
+	)
+	direction := []float64{1, -1, 2}
+
+	intervals, err := ParametricRHSMax(context.Background(), m, direction, -3, 8)
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	for _, iv := range intervals {
+		for _, s := range []float64{0.1, 0.5, 0.9} {
+			param := iv.From + s*(iv.To-iv.From)
+			shifted := tableau(
+				matrix.Row{2, 1, 1, 10 + param},
+				matrix.Row{1, 3, 2, 15 - param},
+				matrix.Row{1, 1, 3, 12 + 2*param},
+				matrix.Row{-4, -5, -3, 0},
+			)
+
+			res, err := SolveMax(context.Background(), shifted, SimplexMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if got := iv.Objective.At(param); math.Abs(got-res.Objective) > 1e-6 {
+				t.Fatalf("Expected to get: %v, got: %v at t = %v", res.Objective, got, param)
+			}
+		}
+	}
+
+	if first, last := intervals[0], intervals[len(intervals)-1]; first.From != -3 || last.To > 8 {
+		t.Fatalf("Expected intervals to stay in [-3; 8], got: [%v; %v]", first.From, last.To)
+	}
+
+	for i := 1; i < len(intervals); i++ {
+		if intervals[i].From != intervals[i-1].To {
+			t.Fatalf("Expected intervals to follow each other, got: %v", intervals)
+		}
+	}
+}
+
+func TestAffine(t *testing.T) {
+	if got := (Affine{Constant: 2, Slope: -3}).At(4); got != -10 {
+		t.Fatalf("Expected to get: %v, got: %v", -10, got)
+	}
+}
+
+func checkIntervals(t *testing.T, got, expected []ParametricInterval, rows int) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("Expected to get: %v, got: %v", expected, got)
+	}
+
+	for i, iv := range got {
+		e := expected[i]
+		if math.Abs(iv.From-e.From) > 1e-6 || math.Abs(iv.To-e.To) > 1e-6 {
+			t.Fatalf("Expected to get: [%v; %v], got: [%v; %v]", e.From, e.To, iv.From, iv.To)
+		}
+
+		if len(iv.Basis) != rows {
+			t.Fatalf("Expected to get basis of %d variables, got: %v", rows, iv.Basis)
+		}
+
+		if !nearAffine(iv.Objective, e.Objective) {
+			t.Fatalf("Expected to get: %v, got: %v on [%v; %v]", e.Objective, iv.Objective, iv.From, iv.To)
+		}
+
+		for j := range e.Solution {
+			if !nearAffine(iv.Solution[j], e.Solution[j]) {
+				t.Fatalf("Expected to get: %v, got: %v on [%v; %v]", e.Solution, iv.Solution, iv.From, iv.To)
+			}
+		}
+	}
+}
+
+func nearAffine(a, b Affine) bool {
+	return math.Abs(a.Constant-b.Constant) < 1e-6 && math.Abs(a.Slope-b.Slope) < 1e-6
+}
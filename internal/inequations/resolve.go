@@ -0,0 +1,153 @@
+package inequations
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// AddConstraint appends the row coefficients*x <= rhs, written with the
+// x variables of the initial tableau, to the optimal tableau. The basic
+// x are substituted, so the row may need a few ResolveMax or ResolveMin
+// pivots to become feasible.
+func (r *Result) AddConstraint(coefficients []float64, rhs float64) error {
+	m := r.Optimal.Matrix
+	if !titled(m) {
+		return ErrUnknownConstraint
+	}
+
+	if len(coefficients) != m.GetXCount() {
+		return fmt.Errorf("constraint should have %d coefficients", m.GetXCount())
+	}
+
+	lastRow := len(m.Rows) - 1
+	lastCol := len(m.Rows[0]) - 1
+	row := make(matrix.Row, lastCol+1)
+	for j, variable := range m.TopTitle[:lastCol] {
+		if variable.IsX() {
+			row[j] = coefficients[variable.FirstStageIndex]
+		}
+	}
+
+	row[lastCol] = rhs
+	for i, variable := range m.LeftTitle[:lastRow] {
+		if !variable.IsX() {
+			continue
+		}
+
+		c := coefficients[variable.FirstStageIndex]
+		for j := range row {
+			row[j] -= c * m.Rows[i][j]
+		}
+	}
+
+	u := nextU(m)
+	res := m.Copy()
+	res.Rows = append(res.Rows[:lastRow:lastRow], row, res.Rows[lastRow])
+	res.LeftTitle = append(append(m.LeftTitle[:lastRow:lastRow], matrix.Variable{
+		FirstStageName:   "y",
+		FirstStageIndex:  u,
+		SecondStageName:  "u",
+		SecondStageIndex: u,
+	}), m.LeftTitle[lastRow])
+	res.TopTitle = append([]matrix.Variable(nil), m.TopTitle...)
+	r.Optimal.Matrix = res
+	return nil
+}
+
+// ShiftFreeTerm adds delta to the free term of the initial row u.
+func (r *Result) ShiftFreeTerm(u int, delta float64) error {
+	m := r.Optimal.Matrix
+	if !titled(m) {
+		return ErrUnknownConstraint
+	}
+
+	lastCol := len(m.Rows[0]) - 1
+	res := m.Copy()
+	res.LeftTitle = append([]matrix.Variable(nil), m.LeftTitle...)
+	res.TopTitle = append([]matrix.Variable(nil), m.TopTitle...)
+
+	for i, variable := range m.LeftTitle {
+		if variable.IsU() && variable.SecondStageIndex == u {
+			res.Rows[i][lastCol] += delta
+			r.Optimal.Matrix = res
+			return nil
+		}
+	}
+
+	// The slack of the row is not basic: it equals zero in the tableau,
+	// so every row moves along its column.
+	for j, variable := range m.TopTitle[:lastCol] {
+		if variable.IsU() && variable.SecondStageIndex == u {
+			for i := range res.Rows {
+				res.Rows[i][lastCol] += res.Rows[i][j] * delta
+			}
+			r.Optimal.Matrix = res
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: u%d", ErrUnknownConstraint, u)
+}
+
+// ResolveMax re-optimizes the result of SolveMax after its optimal
+// tableau was changed, the dual simplex keeps the last row optimal.
+func ResolveMax(ctx context.Context, r Result) (*Result, error) {
+	optimal, err := FindDualSolution(ctx, r.Optimal.Matrix)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved(r, optimal, 1), nil
+}
+
+// ResolveMin is ResolveMax for the result of SolveMin.
+func ResolveMin(ctx context.Context, r Result) (*Result, error) {
+	optimal, err := FindDualSolution(ctx, r.Optimal.Matrix)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved(r, optimal, -1), nil
+}
+
+// resolved reads the objective from the last row, min problems are
+// solved as max ones with the negated objective.
+func resolved(r Result, optimal *Solution, sign float64) *Result {
+	m := optimal.Matrix
+	res := Result{
+		Support:   r.Support,
+		Optimal:   *optimal,
+		Objective: sign * m.Rows[len(m.Rows)-1][len(m.Rows[0])-1],
+	}
+
+	if r.Dual != nil {
+		res.Dual = dualResult(optimal.Matrix)
+	}
+
+	return &res
+}
+
+// nextU numbers the added row after the initial ones, including the
+// equations removed from the tableau and the y rows of the tableaus
+// built without the u titles.
+func nextU(m matrix.Matrix) int {
+	n := m.InitialRows
+	for _, variable := range slices.Concat(m.LeftTitle, m.TopTitle) {
+		if variable.IsU() {
+			n = max(n, variable.SecondStageIndex+1)
+		}
+
+		if variable.FirstStageName == "y" {
+			n = max(n, variable.FirstStageIndex+1)
+		}
+	}
+
+	return n
+}
+
+func titled(m matrix.Matrix) bool {
+	return len(m.Rows) > 0 && len(m.LeftTitle) == len(m.Rows) && len(m.TopTitle) == len(m.Rows[0])
+}
@@ -0,0 +1,153 @@
+package inequations
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// change applies the same change to the solved result and to the
+// tableau which is solved from scratch.
+type change struct {
+	constraint matrix.Row
+	u          int
+	delta      float64
+}
+
+func TestResolve(t *testing.T) {
+	// max 3x1 + 2x2: x1 + x2 <= 4, x1 + 3x2 <= 6 reaches 12 at (4, 0).
+	maxProblem := func() matrix.Matrix {
+		return tableau(matrix.Row{1, 1, 4}, matrix.Row{1, 3, 6}, matrix.Row{-3, -2, 0})
+	}
+
+	// min x1 + x2: x1 + 2x2 >= 2, 2x1 + x2 >= 2 reaches 4/3 at (2/3, 2/3).
+	minProblem := func() matrix.Matrix {
+		return tableau(matrix.Row{-1, -2, -2}, matrix.Row{-2, -1, -2}, matrix.Row{-1, -1, 0})
+	}
+
+	tc := []struct {
+		name      string
+		m         func() matrix.Matrix
+		minimize  bool
+		change    change
+		objective float64
+		err       error
+	}{
+		{
+			name:      "Should add constraint",
+			m:         maxProblem,
+			change:    change{constraint: matrix.Row{1, 0, 3}},
+			objective: 11,
+		},
+		{
+			name:      "Should add redundant constraint",
+			m:         maxProblem,
+			change:    change{constraint: matrix.Row{0, 1, 3}},
+			objective: 12,
+		},
+		{
+			name:      "Should shift free term of binding constraint",
+			m:         maxProblem,
+			change:    change{u: 0, delta: -1},
+			objective: 9,
+		},
+		{
+			name:      "Should shift free term of basic slack",
+			m:         maxProblem,
+			change:    change{u: 1, delta: -3},
+			objective: 9,
+		},
+		{
+			name:      "Should add constraint to min problem",
+			m:         minProblem,
+			minimize:  true,
+			change:    change{constraint: matrix.Row{-1, 0, -1}},
+			objective: 1.5,
+		},
+		{
+			name:      "Should shift free term of min problem",
+			m:         minProblem,
+			minimize:  true,
+			change:    change{u: 1, delta: -2},
+			objective: 2,
+		},
+		{
+			name:   "Should not allow infeasible constraint",
+			m:      maxProblem,
+			change: change{constraint: matrix.Row{-1, -1, -5}},
+			err:    ErrInfeasible,
+		},
+		{
+			name:   "Should not allow unknown constraint",
+			m:      maxProblem,
+			change: change{u: 5, delta: 1},
+			err:    ErrUnknownConstraint,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			solve, resolve := SolveMax, ResolveMax
+			if tt.minimize {
+				solve, resolve = SolveMin, ResolveMin
+			}
+
+			solved, err := solve(context.Background(), tt.m(), DoubledMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			err = tt.change.apply(solved)
+			if err == nil {
+				solved, err = resolve(context.Background(), *solved)
+			}
+
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if math.Abs(solved.Objective-tt.objective) > 1e-6 {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, solved.Objective)
+			}
+
+			expected, err := solve(context.Background(), tt.change.applyTo(tt.m()), DoubledMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			for i, y := range expected.Dual {
+				if math.Abs(solved.Dual[i]-y) > 1e-6 {
+					t.Fatalf("Expected to get dual: %v, got: %v", expected.Dual, solved.Dual)
+				}
+			}
+		})
+	}
+}
+
+func (c change) apply(r *Result) error {
+	if c.constraint != nil {
+		last := len(c.constraint) - 1
+		return r.AddConstraint(c.constraint[:last], c.constraint[last])
+	}
+
+	return r.ShiftFreeTerm(c.u, c.delta)
+}
+
+func (c change) applyTo(m matrix.Matrix) matrix.Matrix {
+	last := len(m.Rows) - 1
+	if c.constraint != nil {
+		rows := append(m.Rows[:last:last], c.constraint, m.Rows[last])
+		return tableau(rows...)
+	}
+
+	m.Rows[c.u][len(m.Rows[c.u])-1] += c.delta
+	return m
+}
@@ -1,7 +1,7 @@
 package inequations
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/hrvadl/algo/internal/matrix"
 )
@@ -21,8 +21,8 @@ type MinSolution struct {
 	Min float64
 }
 
-func FindMinWithOptimalSolution(m matrix.Matrix) (*MinSolution, error) {
-	sol, err := FindMaxWithOptimalSolution(m)
+func FindMinWithOptimalSolution(ctx context.Context, m matrix.Matrix) (*MinSolution, error) {
+	sol, err := FindMaxWithOptimalSolution(ctx, m)
 	if err != nil {
 		return nil, err
 	}
@@ -33,17 +33,17 @@ func FindMinWithOptimalSolution(m matrix.Matrix) (*MinSolution, error) {
 	}, nil
 }
 
-func FindMinWithSupportSolution(m matrix.Matrix) (Solution, error) {
+func FindMinWithSupportSolution(ctx context.Context, m matrix.Matrix) (Solution, error) {
 	lastRow := len(m.Rows) - 1
 	for i := range m.Rows[lastRow] {
 		m.Rows[lastRow][i] /= -1
 	}
 
-	return FindSupportSolution(m)
+	return FindSupportSolution(ctx, m)
 }
 
-func FindMaxWithOptimalSolution(m matrix.Matrix) (*MaxSolution, error) {
-	optimal, err := FindOptimalSolution(m)
+func FindMaxWithOptimalSolution(ctx context.Context, m matrix.Matrix) (*MaxSolution, error) {
+	optimal, err := FindOptimalSolution(ctx, m)
 	if err != nil {
 		return nil, err
 	}
@@ -56,10 +56,15 @@ func FindMaxWithOptimalSolution(m matrix.Matrix) (*MaxSolution, error) {
 	}, nil
 }
 
-func FindOptimalSolution(m matrix.Matrix) (*Solution, error) {
+func FindOptimalSolution(ctx context.Context, m matrix.Matrix) (*Solution, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	lastCol := len(m.Rows[0]) - 1
 	res := make([]float64, m.GetXCount())
 
+	matrix.Printf(ctx, "\nFinding the optimal solution...\n")
 	col, err := m.FirstNegativeColumnInLastRow()
 	if err != nil {
 		for row, variable := range m.LeftTitle {
@@ -79,19 +84,24 @@ func FindOptimalSolution(m matrix.Matrix) (*Solution, error) {
 		return nil, err
 	}
 
+	before := matrix.PivotSnapshot(ctx, m)
 	m, err = m.JordanEliminateModified(col, row)
 	if err != nil {
 		return nil, err
 	}
 
-	fmt.Printf("\nElement col: %v row: %v, Matrix:\n\n", col, row)
-	rm := m.Round()
-	rm.Print()
+	matrix.NotifyPivot(ctx, OptimalStage, row, col, before, m)
+
+	matrix.PrintStep(ctx, m, "\nElement col: %v row: %v, Matrix:\n\n", col, row)
 
-	return FindOptimalSolution(m)
+	return FindOptimalSolution(ctx, m)
 }
 
-func FindSupportSolution(m matrix.Matrix) (Solution, error) {
+func FindSupportSolution(ctx context.Context, m matrix.Matrix) (Solution, error) {
+	if err := ctx.Err(); err != nil {
+		return Solution{}, err
+	}
+
 	lastCol := len(m.Rows[0]) - 1
 	res := make([]float64, m.GetXCount())
 
@@ -115,13 +125,14 @@ func FindSupportSolution(m matrix.Matrix) (Solution, error) {
 		return Solution{}, err
 	}
 
+	before := matrix.PivotSnapshot(ctx, m)
 	m, err = m.JordanEliminateModified(col, row)
 	if err != nil {
 		return Solution{}, err
 	}
 
-	fmt.Printf("\nElement col: %v row: %v, Matrix:\n\n", col, row)
-	rm := m.Round()
-	rm.Print()
-	return FindSupportSolution(m)
+	matrix.NotifyPivot(ctx, SupportStage, row, col, before, m)
+
+	matrix.PrintStep(ctx, m, "\nElement col: %v row: %v, Matrix:\n\n", col, row)
+	return FindSupportSolution(ctx, m)
 }
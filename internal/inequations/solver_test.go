@@ -1,6 +1,7 @@
 package inequations
 
 import (
+	"context"
 	"reflect"
 	"slices"
 	"testing"
@@ -43,7 +44,7 @@ func TestFindMaxWithSupportSolution(t *testing.T) {
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			actual, _ := FindSupportSolution(tt.m)
+			actual, _ := FindSupportSolution(context.Background(), tt.m)
 			if !slices.Equal(actual.Result, tt.expected) {
 				t.Fatalf("Expected %v, \ngot %v", tt.expected, actual)
 			}
@@ -88,12 +89,12 @@ func TestFindMaxWithOptimalSolution(t *testing.T) {
 
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
-			support, err := FindSupportSolution(tt.m)
+			support, err := FindSupportSolution(context.Background(), tt.m)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			actual, err := FindMaxWithOptimalSolution(support.Matrix)
+			actual, err := FindMaxWithOptimalSolution(context.Background(), support.Matrix)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -134,12 +135,12 @@ func TestFindMinWithOptimalSolution(t *testing.T) {
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			support, err := FindMinWithSupportSolution(tt.m)
+			support, err := FindMinWithSupportSolution(context.Background(), tt.m)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			actual, err := FindMinWithOptimalSolution(support.Matrix)
+			actual, err := FindMinWithOptimalSolution(context.Background(), support.Matrix)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -222,12 +223,12 @@ func TestHandleGetMinWithOptimalSolution(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			support, err := FindSupportSolution(m)
+			support, err := FindSupportSolution(context.Background(), m)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			optimal, err := FindMaxWithOptimalSolution(support.Matrix)
+			optimal, err := FindMaxWithOptimalSolution(context.Background(), support.Matrix)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -0,0 +1,8 @@
+package inequations
+
+import "github.com/hrvadl/algo/internal/matrix"
+
+const (
+	SupportStage matrix.Stage = "support"
+	OptimalStage matrix.Stage = "optimal"
+)
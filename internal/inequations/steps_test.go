@@ -0,0 +1,58 @@
+package inequations
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestPivotObserver(t *testing.T) {
+	m := matrix.Matrix{
+		Rows: []matrix.Row{
+			{1, 1, -1, -2, 6},
+			{-1, -1, -1, 1, -5},
+			{2, -1, 3, 4, 10},
+			{-1, -2, 1, 1, 0},
+		},
+	}
+	m.FillLeftTitle()
+	m.FillTopTitle()
+
+	var outer, inner []matrix.PivotStep
+	ctx := matrix.WithPivotObserver(context.Background(), func(s matrix.PivotStep) { outer = append(outer, s) })
+	ctx = matrix.WithPivotObserver(ctx, func(s matrix.PivotStep) { inner = append(inner, s) })
+
+	support, err := FindSupportSolution(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	optimal, err := FindOptimalSolution(ctx, support.Matrix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(inner) == 0 || !reflect.DeepEqual(outer, inner) {
+		t.Fatalf("Expected both observers to get the same steps, got: %d and %d", len(outer), len(inner))
+	}
+
+	if first := inner[0]; first.Stage != SupportStage || !reflect.DeepEqual(first.Before.Rows, m.Rows) {
+		t.Fatalf("Expected first step to start from the input tableau, got: %+v", first)
+	}
+
+	for i := 1; i < len(inner); i++ {
+		if !reflect.DeepEqual(inner[i].Before, inner[i-1].After) {
+			t.Fatalf("Expected step %d to start from the previous tableau", i)
+		}
+
+		if inner[i].Before.Rows[inner[i].Row][inner[i].Col] == 0 {
+			t.Fatalf("Expected pivot of step %d not to be zero", i)
+		}
+	}
+
+	if last := inner[len(inner)-1]; !reflect.DeepEqual(last.After.Rows, optimal.Matrix.Rows) {
+		t.Fatalf("Expected last step to produce the optimal tableau")
+	}
+}
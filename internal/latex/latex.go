@@ -0,0 +1,206 @@
+package latex
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const (
+	DefaultPrecision = 2
+	DefaultTitle     = "Jordan elimination steps"
+	PivotColor       = "yellow!40"
+)
+
+// Cell points to the pivot element of a table, NoPivot disables highlighting.
+type Cell struct {
+	Row int
+	Col int
+}
+
+var NoPivot = Cell{Row: -1, Col: -1}
+
+type Document struct {
+	Title     string
+	Precision int
+	Steps     []matrix.PivotStep
+}
+
+var stageTitles = map[matrix.Stage]string{
+	matrix.SwapStage:         "Jordan elimination",
+	matrix.InvertStage:       "Inverse matrix",
+	inequations.SupportStage: "Support solution",
+	inequations.OptimalStage: "Optimal solution",
+}
+
+// Write renders d as a standalone document which compiles with pdflatex.
+func Write(w io.Writer, d Document) error {
+	if d.Title == "" {
+		d.Title = DefaultTitle
+	}
+
+	if d.Precision <= 0 {
+		d.Precision = DefaultPrecision
+	}
+
+	var b strings.Builder
+	b.WriteString("\\documentclass{article}\n")
+	b.WriteString("\\usepackage[utf8]{inputenc}\n")
+	b.WriteString("\\usepackage[table]{xcolor}\n")
+	b.WriteString("\\usepackage[margin=2cm]{geometry}\n")
+	fmt.Fprintf(&b, "\\title{%s}\n", Escape(d.Title))
+	b.WriteString("\\date{}\n\n")
+	b.WriteString("\\begin{document}\n\\maketitle\n")
+
+	if len(d.Steps) == 0 {
+		b.WriteString("\nThere are no elimination steps.\n")
+	}
+
+	var stage matrix.Stage
+	for i, s := range d.Steps {
+		if i == 0 || s.Stage != stage {
+			stage = s.Stage
+			fmt.Fprintf(&b, "\n\\section*{%s}\n", Escape(StageTitle(stage)))
+		}
+
+		before := withTitles(s.Before)
+		fmt.Fprintf(
+			&b,
+			"\n\\subsection*{Step %d}\nPivot element $a_{%d,%d} = %s$ in row %s, column %s.\n\n",
+			i+1,
+			s.Row,
+			s.Col,
+			Number(s.Pivot(), d.Precision),
+			Variable(before.LeftTitle[s.Row]),
+			Variable(before.TopTitle[s.Col]),
+		)
+		b.WriteString(Table(before, Cell{Row: s.Row, Col: s.Col}, d.Precision))
+	}
+
+	if len(d.Steps) > 0 {
+		b.WriteString("\n\\section*{Result}\n\n")
+		b.WriteString(Table(d.Steps[len(d.Steps)-1].After, NoPivot, d.Precision))
+	}
+
+	b.WriteString("\n\\end{document}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func StageTitle(s matrix.Stage) string {
+	if title, ok := stageTitles[s]; ok {
+		return title
+	}
+
+	return string(s)
+}
+
+// Table renders m as a tabular with the titles of the tableau, titles
+// are filled the same way as the elimination does when m has none.
+func Table(m matrix.Matrix, pivot Cell, precision int) string {
+	if len(m.Rows) == 0 {
+		return ""
+	}
+
+	m = withTitles(m)
+	var b strings.Builder
+	fmt.Fprintf(&b, "\\begin{center}\n\\begin{tabular}{c|%s}\n", strings.Repeat("r", len(m.Rows[0])))
+	for _, v := range m.TopTitle {
+		fmt.Fprintf(&b, " & %s", Variable(v))
+	}
+	b.WriteString(" \\\\\n\\hline\n")
+
+	for i, row := range m.Rows {
+		if i > 0 && m.LeftTitle[i].IsZ() {
+			b.WriteString("\\hline\n")
+		}
+
+		b.WriteString(Variable(m.LeftTitle[i]))
+		for j, el := range row {
+			if i == pivot.Row && j == pivot.Col {
+				fmt.Fprintf(&b, " & \\cellcolor{%s}$\\mathbf{%s}$", PivotColor, Number(el, precision))
+				continue
+			}
+
+			fmt.Fprintf(&b, " & $%s$", Number(el, precision))
+		}
+		b.WriteString(" \\\\\n")
+	}
+
+	b.WriteString("\\end{tabular}\n\\end{center}\n")
+	return b.String()
+}
+
+// Variable renders the title like y0 u0 as $y_{0}\;u_{0}$, the service
+// names (z, w, 1, 0) are rendered without indexes.
+func Variable(v matrix.Variable) string {
+	first := variablePart(v.FirstStageName, v.FirstStageIndex)
+	second := variablePart(v.SecondStageName, v.SecondStageIndex)
+	switch {
+	case first == "" && second == "":
+		return ""
+	case first == "":
+		return "$" + second + "$"
+	case second == "":
+		return "$" + first + "$"
+	}
+
+	return "$" + first + `\;` + second + "$"
+}
+
+func variablePart(name string, index int) string {
+	switch name {
+	case "":
+		return ""
+	case "z", "w", "1", "0":
+		return Escape(name)
+	}
+
+	return fmt.Sprintf("%s_{%d}", Escape(name), index)
+}
+
+func withTitles(m matrix.Matrix) matrix.Matrix {
+	if len(m.Rows) == 0 {
+		return m
+	}
+
+	if len(m.LeftTitle) != len(m.Rows) {
+		m.FillLeftTitle()
+	}
+
+	if len(m.TopTitle) != len(m.Rows[0]) {
+		m.FillTopTitle()
+	}
+
+	return m
+}
+
+func Number(n float64, precision int) string {
+	n = matrix.RoundTo(n, precision)
+	if n == 0 {
+		n = 0
+	}
+
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+var escaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+)
+
+func Escape(s string) string {
+	return escaper.Replace(s)
+}
@@ -0,0 +1,163 @@
+package latex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestVariable(t *testing.T) {
+	tc := []struct {
+		name     string
+		v        matrix.Variable
+		expected string
+	}{
+		{
+			name: "Should render both stages",
+			v: matrix.Variable{
+				FirstStageName:   "y",
+				FirstStageIndex:  0,
+				SecondStageName:  "u",
+				SecondStageIndex: 0,
+			},
+			expected: `$y_{0}\;u_{0}$`,
+		},
+		{
+			name:     "Should render service names without indexes",
+			v:        matrix.Variable{FirstStageName: "z", SecondStageName: "1"},
+			expected: `$z\;1$`,
+		},
+		{
+			name:     "Should render second stage only",
+			v:        matrix.Variable{SecondStageName: "v", SecondStageIndex: 2},
+			expected: `$v_{2}$`,
+		},
+		{
+			name:     "Should escape names",
+			v:        matrix.Variable{FirstStageName: "x_", FirstStageIndex: 1},
+			expected: `$x\__{1}$`,
+		},
+		{
+			name:     "Should render empty variable",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Variable(tt.v); got != tt.expected {
+				t.Fatalf("Expected to get: %s, got: %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNumber(t *testing.T) {
+	tc := []struct {
+		name      string
+		n         float64
+		precision int
+		expected  string
+	}{
+		{name: "Should render integer", n: 3, precision: 2, expected: "3"},
+		{name: "Should round fraction", n: 1.0 / 3, precision: 2, expected: "0.33"},
+		{name: "Should not render negative zero", n: -0.0001, precision: 2, expected: "0"},
+		{name: "Should render negative", n: -1.5, precision: 2, expected: "-1.5"},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Number(tt.n, tt.precision); got != tt.expected {
+				t.Fatalf("Expected to get: %s, got: %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTable(t *testing.T) {
+	m := matrix.Matrix{
+		Rows: []matrix.Row{
+			{1, 2},
+			{3, 4},
+		},
+	}
+
+	expected := `\begin{center}
+\begin{tabular}{c|rr}
+ & $x_{0}\;v_{0}$ & $1\;w$ \\
+\hline
+$y_{0}\;u_{0}$ & $1$ & \cellcolor{yellow!40}$\mathbf{2}$ \\
+\hline
+$z\;1$ & $3$ & $4$ \\
+\end{tabular}
+\end{center}
+`
+
+	if got := Table(m, Cell{Row: 0, Col: 1}, DefaultPrecision); got != expected {
+		t.Fatalf("Expected to get:\n%s\ngot:\n%s", expected, got)
+	}
+
+	if m.LeftTitle != nil || m.TopTitle != nil {
+		t.Fatalf("Expected Table not to change the matrix titles")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	m := matrix.Matrix{
+		Rows: []matrix.Row{
+			{1, 1, -1, -2, 6},
+			{-1, -1, -1, 1, -5},
+			{2, -1, 3, 4, 10},
+			{-1, -2, 1, 1, 0},
+		},
+	}
+	m.FillLeftTitle()
+	m.FillTopTitle()
+
+	ctx, recorder := matrix.Record(context.Background())
+	support, err := inequations.FindSupportSolution(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := inequations.FindOptimalSolution(ctx, support.Matrix); err != nil {
+		t.Fatal(err)
+	}
+
+	steps := recorder.Steps()
+	var b strings.Builder
+	if err := Write(&b, Document{Title: "LP & co", Steps: steps}); err != nil {
+		t.Fatal(err)
+	}
+
+	doc := b.String()
+	for _, part := range []string{
+		`\documentclass{article}`,
+		`\title{LP \& co}`,
+		`\begin{document}`,
+		`\section*{Support solution}`,
+		`\section*{Optimal solution}`,
+		`\section*{Result}`,
+	} {
+		if !strings.Contains(doc, part) {
+			t.Fatalf("Expected document to contain: %s", part)
+		}
+	}
+
+	if !strings.HasSuffix(doc, "\\end{document}\n") {
+		t.Fatalf("Expected document to end with \\end{document}")
+	}
+
+	if got := strings.Count(doc, `\cellcolor`); got != len(steps) {
+		t.Fatalf("Expected to highlight: %d pivots, got: %d", len(steps), got)
+	}
+
+	if got := strings.Count(doc, `\begin{tabular}`); got != len(steps)+1 {
+		t.Fatalf("Expected to get: %d tables, got: %d", len(steps)+1, got)
+	}
+}
@@ -0,0 +1,483 @@
+package lp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// Tableau is the problem compiled to the form the inequations solvers
+// expect: every constraint is a "<=" row with negated coefficients in
+// the last row, equations are marked with the "0" variables.
+type Tableau struct {
+	Matrix matrix.Matrix
+
+	problem  Problem
+	columns  []column
+	shifts   []float64
+	constant float64
+	bounds   []inequations.Bound
+	// rows holds the tableau row of every constraint, the added ones go
+	// after the rows of the upper bounds.
+	rows []int
+	// solved is the last raw result of Solve or Resolve.
+	solved *inequations.Result
+}
+
+// column maps a tableau column back to the problem variable:
+// x = shift + sign*column, free variables occupy two columns.
+type column struct {
+	variable int
+	sign     float64
+}
+
+type upperBound struct {
+	col   int
+	value float64
+}
+
+// Solution is the solver result mapped back to the problem variables.
+// Result keeps the raw result in terms of the tableau columns.
+type Solution struct {
+	Variables []string
+	Support   []float64
+	Optimal   []float64
+	Objective float64
+	Dual      []float64
+	Result    inequations.Result
+}
+
+func (s Solution) Values() map[string]float64 {
+	values := make(map[string]float64, len(s.Variables))
+	for i, v := range s.Variables {
+		values[v] = s.Optimal[i]
+	}
+
+	return values
+}
+
+// Compile substitutes bounded and free variables by non-negative ones,
+// upper bounds become additional constraints.
+func (p Problem) Compile() (*Tableau, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	t := &Tableau{
+		problem: p,
+		columns: make([]column, 0, len(p.Variables)),
+		shifts:  make([]float64, len(p.Variables)),
+	}
+
+	var upper []upperBound
+	for i := range p.Variables {
+		b := p.Bound(i)
+		switch {
+		case math.IsInf(b.Lower, -1) && math.IsInf(b.Upper, 1):
+			t.columns = append(t.columns, column{variable: i, sign: 1}, column{variable: i, sign: -1})
+			continue
+		case math.IsInf(b.Lower, -1):
+			t.shifts[i] = b.Upper
+			t.columns = append(t.columns, column{variable: i, sign: -1})
+			continue
+		}
+
+		t.shifts[i] = b.Lower
+		if !math.IsInf(b.Upper, 1) {
+			upper = append(upper, upperBound{col: len(t.columns), value: b.Upper - b.Lower})
+		}
+		t.columns = append(t.columns, column{variable: i, sign: 1})
+	}
+
+	n := len(t.columns)
+	rows := len(p.Constraints) + len(upper)
+	t.Matrix = matrix.Matrix{
+		Rows:      make([]matrix.Row, 0, rows+1),
+		LeftTitle: make([]matrix.Variable, rows+1),
+	}
+
+	t.rows = make([]int, len(p.Constraints))
+	for i, c := range p.Constraints {
+		t.rows[i] = i
+		t.Matrix.Rows = append(t.Matrix.Rows, t.compileRow(c))
+		t.Matrix.LeftTitle[i] = slack(i)
+		if c.Relation == Equal {
+			t.Matrix.LeftTitle[i] = matrix.Variable{
+				FirstStageName:   "0",
+				SecondStageName:  "u",
+				SecondStageIndex: i,
+			}
+		}
+	}
+
+	for k, u := range upper {
+		row := make(matrix.Row, n+1)
+		row[u.col] = 1
+		row[n] = u.value
+		i := len(p.Constraints) + k
+		t.Matrix.LeftTitle[i] = slack(i)
+		t.Matrix.Rows = append(t.Matrix.Rows, row)
+	}
+
+	z, constant := t.substitute(p.Objective)
+	for j := range z {
+		z[j] *= -1
+	}
+	t.constant = constant
+	t.Matrix.Rows = append(t.Matrix.Rows, append(z, 0))
+
+	t.Matrix.FillTopTitle()
+	t.Matrix.InitialCols = len(t.Matrix.Rows[0])
+	t.Matrix.InitialRows = len(t.Matrix.Rows) - 1
+	return t, nil
+}
+
+var ErrImplicitBounds = errors.New("tableau with implicit bounds should be solved with the bounded method")
+
+// CompileBounded keeps a column per variable and leaves the bounds to
+// the bounded simplex, so neither upper bounds add rows nor free
+// variables are split. Only BoundedMethod can solve such a tableau.
+func (p Problem) CompileBounded() (*Tableau, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	n := len(p.Variables)
+	t := &Tableau{
+		problem: p,
+		columns: make([]column, n),
+		shifts:  make([]float64, n),
+		bounds:  make([]inequations.Bound, n),
+	}
+
+	for i := range p.Variables {
+		t.columns[i] = column{variable: i, sign: 1}
+		t.bounds[i] = p.Bound(i)
+	}
+
+	compiled, err := Problem{
+		Sense:       p.Sense,
+		Variables:   p.Variables,
+		Objective:   p.Objective,
+		Constraints: p.Constraints,
+	}.Compile()
+	if err != nil {
+		return nil, err
+	}
+
+	t.Matrix = compiled.Matrix
+	t.rows = compiled.rows
+	return t, nil
+}
+
+// compileRow substitutes the columns into the constraint, ">=" rows are
+// negated to become "<=" ones.
+func (t *Tableau) compileRow(c Constraint) matrix.Row {
+	row, rhs := t.substitute(c.Coefficients)
+	row = append(row, c.RHS-rhs)
+	if c.Relation == GreaterEqual {
+		for j := range row {
+			row[j] *= -1
+		}
+	}
+
+	return row
+}
+
+// substitute rewrites the coefficients in terms of the tableau columns,
+// the second value is the constant the shifts contribute.
+func (t *Tableau) substitute(coefficients []float64) (matrix.Row, float64) {
+	row := make(matrix.Row, len(t.columns), len(t.columns)+1)
+	for j, c := range t.columns {
+		row[j] = c.sign * coefficients[c.variable]
+	}
+
+	var constant float64
+	for i, shift := range t.shifts {
+		constant += coefficients[i] * shift
+	}
+
+	return row, constant
+}
+
+// Decode maps the values of the tableau columns to the problem variables.
+func (t *Tableau) Decode(x []float64) []float64 {
+	values := make([]float64, len(t.problem.Variables))
+	copy(values, t.shifts)
+	for j, c := range t.columns {
+		if j < len(x) {
+			values[c.variable] += c.sign * x[j]
+		}
+	}
+
+	return values
+}
+
+// DecodeResult maps the whole solver result. Dual holds the shadow
+// prices of the constraints, the solver reports them for "<=" rows of
+// max problems and ">=" rows of min problems, the rest change the sign.
+func (t *Tableau) DecodeResult(res inequations.Result) Solution {
+	sol := Solution{
+		Variables: t.problem.Variables,
+		Support:   t.Decode(res.Support.Result),
+		Optimal:   t.Decode(res.Optimal.Result),
+		Objective: res.Objective + t.constant,
+		Result:    res,
+	}
+
+	if res.Dual == nil {
+		return sol
+	}
+
+	sol.Dual = make([]float64, len(t.problem.Constraints))
+	maximize := t.problem.Sense == Maximize
+	for i, c := range t.problem.Constraints {
+		if t.rows[i] >= len(res.Dual) {
+			continue
+		}
+
+		sol.Dual[i] = res.Dual[t.rows[i]]
+		if (c.Relation == GreaterEqual) == maximize && sol.Dual[i] != 0 {
+			sol.Dual[i] *= -1
+		}
+	}
+
+	return sol
+}
+
+// Solve runs the solver on a copy of the tableau and decodes the result.
+func (t *Tableau) Solve(ctx context.Context, method inequations.Method) (*Solution, error) {
+	if method == inequations.BoundedMethod {
+		return t.solveBounded(ctx)
+	}
+
+	if t.bounds != nil {
+		return nil, ErrImplicitBounds
+	}
+
+	m := clone(t.Matrix)
+	m, err := m.DeleteZeros()
+	if err != nil {
+		return nil, err
+	}
+
+	solve := inequations.SolveMax
+	if t.problem.Sense == Minimize {
+		solve = inequations.SolveMin
+	}
+
+	res, err := solve(ctx, m, method)
+	if err != nil {
+		return nil, err
+	}
+
+	t.solved = res
+	sol := t.DecodeResult(*res)
+	return &sol, nil
+}
+
+// solveBounded keeps the equations since the bounded simplex fixes
+// their slacks to zero.
+func (t *Tableau) solveBounded(ctx context.Context) (*Solution, error) {
+	solve := inequations.SolveBoundedMax
+	if t.problem.Sense == Minimize {
+		solve = inequations.SolveBoundedMin
+	}
+
+	res, err := solve(ctx, clone(t.Matrix), t.bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	sol := t.DecodeResult(*res)
+	return &sol, nil
+}
+
+var (
+	ErrNotSolved  = errors.New("tableau should be solved first")
+	ErrAddedEqual = errors.New("only inequalities can be added to the solved tableau")
+)
+
+// AddConstraint adds the constraint to the problem, its row is compiled
+// the way Compile does it. The last solution gets the row as well, so
+// Resolve re-optimizes it instead of solving the problem from scratch.
+func (t *Tableau) AddConstraint(c Constraint) error {
+	if t.bounds != nil {
+		return ErrImplicitBounds
+	}
+
+	switch c.Relation {
+	case LessEqual, GreaterEqual:
+	case Equal:
+		return ErrAddedEqual
+	default:
+		return ErrInvalidRelation
+	}
+
+	if len(c.Coefficients) != len(t.problem.Variables) {
+		return fmt.Errorf("constraint should have %d coefficients", len(t.problem.Variables))
+	}
+
+	row := t.compileRow(c)
+	last := len(t.Matrix.Rows) - 1
+	u := last
+	if t.solved != nil {
+		n := len(row) - 1
+		if err := t.solved.AddConstraint(row[:n], row[n]); err != nil {
+			return err
+		}
+
+		m := t.solved.Optimal.Matrix
+		u = m.LeftTitle[len(m.LeftTitle)-2].SecondStageIndex
+	}
+
+	m := clone(t.Matrix)
+	m.Rows = append(m.Rows[:last:last], row, m.Rows[last])
+	m.LeftTitle = append(m.LeftTitle[:last:last], slack(u), m.LeftTitle[last])
+	m.InitialRows = len(m.Rows) - 1
+	t.Matrix = m
+
+	t.problem.Constraints = append(t.problem.Constraints[:len(t.problem.Constraints):len(t.problem.Constraints)], c)
+	t.rows = append(t.rows[:len(t.rows):len(t.rows)], u)
+	return nil
+}
+
+// ShiftRHS adds delta to the right hand side of the i-th constraint of
+// the problem and of the last solution, which Resolve re-optimizes.
+func (t *Tableau) ShiftRHS(i int, delta float64) error {
+	if t.bounds != nil {
+		return ErrImplicitBounds
+	}
+
+	if i < 0 || i >= len(t.problem.Constraints) {
+		return fmt.Errorf("%w: %d", inequations.ErrUnknownConstraint, i)
+	}
+
+	c := t.problem.Constraints[i]
+	shift := delta
+	if c.Relation == GreaterEqual {
+		shift *= -1
+	}
+
+	if t.solved != nil {
+		if err := t.solved.ShiftFreeTerm(t.rows[i], shift); err != nil {
+			return err
+		}
+	}
+
+	m := clone(t.Matrix)
+	m.Rows[t.rows[i]][len(m.Rows[0])-1] += shift
+	t.Matrix = m
+
+	c.RHS += delta
+	t.problem.Constraints = slices.Clone(t.problem.Constraints)
+	t.problem.Constraints[i] = c
+	return nil
+}
+
+// Resolve re-optimizes the last solution after AddConstraint or ShiftRHS
+// with the dual simplex.
+func (t *Tableau) Resolve(ctx context.Context) (*Solution, error) {
+	if t.bounds != nil {
+		return nil, ErrImplicitBounds
+	}
+
+	if t.solved == nil {
+		return nil, ErrNotSolved
+	}
+
+	resolve := inequations.ResolveMax
+	if t.problem.Sense == Minimize {
+		resolve = inequations.ResolveMin
+	}
+
+	resolved, err := resolve(ctx, *t.solved)
+	if err != nil {
+		return nil, err
+	}
+
+	t.solved = resolved
+	sol := t.DecodeResult(*resolved)
+	return &sol, nil
+}
+
+// Solve compiles the problem and solves it with the given method, the
+// bounded method gets the bounds as they are.
+func Solve(ctx context.Context, p Problem, method inequations.Method) (*Solution, error) {
+	compile := p.Compile
+	if method == inequations.BoundedMethod {
+		compile = p.CompileBounded
+	}
+
+	t, err := compile()
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Solve(ctx, method)
+}
+
+// FromTableau reads the problem back from the tableau built the way
+// Compile does it, the tableau itself is left untouched.
+func FromTableau(sense Sense, m matrix.Matrix) (Problem, error) {
+	if len(m.Rows) < 2 || len(m.Rows[0]) < 2 {
+		return Problem{}, errors.New("tableau should have constraints, variables and the free terms")
+	}
+
+	n := len(m.Rows[0]) - 1
+	last := len(m.Rows) - 1
+	p := Problem{
+		Sense:       sense,
+		Variables:   make([]string, n),
+		Objective:   make([]float64, n),
+		Constraints: make([]Constraint, 0, last),
+	}
+
+	for j := range p.Variables {
+		p.Variables[j] = fmt.Sprintf("x%d", j+1)
+		p.Objective[j] = -m.Rows[last][j]
+	}
+
+	for i, row := range m.Rows[:last] {
+		if len(row) != n+1 {
+			return Problem{}, errors.New("rows should have the same size")
+		}
+
+		c := Constraint{
+			Coefficients: append([]float64(nil), row[:n]...),
+			Relation:     LessEqual,
+			RHS:          row[n],
+		}
+
+		if i < len(m.LeftTitle) && m.LeftTitle[i].IsZero() {
+			c.Relation = Equal
+		}
+
+		p.Constraints = append(p.Constraints, c)
+	}
+
+	return p, p.Validate()
+}
+
+func slack(i int) matrix.Variable {
+	return matrix.Variable{
+		FirstStageName:   "y",
+		FirstStageIndex:  i,
+		SecondStageName:  "u",
+		SecondStageIndex: i,
+	}
+}
+
+// clone copies the matrix together with its titles since the elimination
+// swaps titles in place.
+func clone(m matrix.Matrix) matrix.Matrix {
+	c := m.Copy()
+	c.LeftTitle = append([]matrix.Variable(nil), m.LeftTitle...)
+	c.TopTitle = append([]matrix.Variable(nil), m.TopTitle...)
+	return c
+}
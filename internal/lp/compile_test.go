@@ -0,0 +1,586 @@
+package lp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestCompile(t *testing.T) {
+	tc := []struct {
+		name     string
+		problem  Problem
+		expected matrix.Matrix
+	}{
+		{
+			name: "Should compile every relation",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: GreaterEqual, RHS: 2},
+					{Coefficients: []float64{2, 1}, Relation: Equal, RHS: 3},
+				},
+			},
+			expected: matrix.Matrix{
+				Rows: []matrix.Row{
+					{1, 1, 4},
+					{-1, 1, -2},
+					{2, 1, 3},
+					{-3, -2, 0},
+				},
+				LeftTitle: []matrix.Variable{
+					{FirstStageName: "y", FirstStageIndex: 0, SecondStageName: "u", SecondStageIndex: 0},
+					{FirstStageName: "y", FirstStageIndex: 1, SecondStageName: "u", SecondStageIndex: 1},
+					{FirstStageName: "0", SecondStageName: "u", SecondStageIndex: 2},
+					{},
+				},
+			},
+		},
+		{
+			name: "Should substitute bounded and free variables",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"a", "b", "c"},
+				Objective: []float64{1, 1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1, 1}, Relation: LessEqual, RHS: 10},
+				},
+				Bounds: []Bound{
+					{Lower: 1, Upper: 3},
+					Free,
+					{Lower: math.Inf(-1), Upper: 2},
+				},
+			},
+			expected: matrix.Matrix{
+				Rows: []matrix.Row{
+					{1, 1, -1, -1, 7},
+					{1, 0, 0, 0, 2},
+					{-1, -1, 1, 1, 0},
+				},
+				LeftTitle: []matrix.Variable{
+					{FirstStageName: "y", FirstStageIndex: 0, SecondStageName: "u", SecondStageIndex: 0},
+					{FirstStageName: "y", FirstStageIndex: 1, SecondStageName: "u", SecondStageIndex: 1},
+					{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.problem.Compile()
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			tt.expected.FillTopTitle()
+			tt.expected.InitialCols = len(tt.expected.Rows[0])
+			tt.expected.InitialRows = len(tt.expected.Rows) - 1
+			if !reflect.DeepEqual(got.Matrix, tt.expected) {
+				t.Fatalf("Expected to get: %+v, got: %+v", tt.expected, got.Matrix)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	p := Problem{
+		Sense:     Maximize,
+		Variables: []string{"a", "b", "c"},
+		Objective: []float64{1, 1, 1},
+		Constraints: []Constraint{
+			{Coefficients: []float64{1, 1, 1}, Relation: LessEqual, RHS: 10},
+		},
+		Bounds: []Bound{{Lower: 1, Upper: 3}, Free, {Lower: math.Inf(-1), Upper: 2}},
+	}
+
+	tableau, err := p.Compile()
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	expected := []float64{2, -3, 0}
+	if got := tableau.Decode([]float64{1, 1, 4, 2}); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected to get: %v, got: %v", expected, got)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	tc := []struct {
+		name      string
+		problem   Problem
+		optimal   []float64
+		objective float64
+		dual      []float64
+	}{
+		{
+			name: "Should solve max problem",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+				},
+			},
+			optimal:   []float64{3, 1},
+			objective: 11,
+			dual:      []float64{2.5, 0.5},
+		},
+		{
+			name: "Should solve min problem",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: GreaterEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+				},
+			},
+			optimal:   []float64{0, 4},
+			objective: 8,
+			dual:      []float64{2, 0},
+		},
+		{
+			name: "Should respect upper bound",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"a", "b"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+				},
+				Bounds: []Bound{{Lower: 0, Upper: 2}, NonNegative},
+			},
+			optimal:   []float64{2, 2},
+			objective: 10,
+			dual:      []float64{2, 0},
+		},
+		{
+			name: "Should shift lower bound",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"a", "b"},
+				Objective: []float64{1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: GreaterEqual, RHS: 2},
+				},
+				Bounds: []Bound{{Lower: 1, Upper: math.Inf(1)}, NonNegative},
+			},
+			optimal:   []float64{2, 0},
+			objective: 2,
+			dual:      []float64{1},
+		},
+		{
+			name: "Should solve free variable",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"a"},
+				Objective: []float64{1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1}, Relation: GreaterEqual, RHS: -5},
+				},
+				Bounds: []Bound{Free},
+			},
+			optimal:   []float64{-5},
+			objective: -5,
+			dual:      []float64{1},
+		},
+		{
+			name: "Should report shadow prices with sign",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"a", "b"},
+				Objective: []float64{-1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 0}, Relation: LessEqual, RHS: 3},
+					{Coefficients: []float64{0, 1}, Relation: GreaterEqual, RHS: 2},
+				},
+			},
+			optimal:   []float64{3, 2},
+			objective: -1,
+			dual:      []float64{-1, 1},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Solve(context.Background(), tt.problem, inequations.DoubledMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(got.Optimal, tt.optimal) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.optimal, got.Optimal)
+			}
+
+			if got.Objective != tt.objective {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, got.Objective)
+			}
+
+			if !reflect.DeepEqual(got.Dual, tt.dual) {
+				t.Fatalf("Expected to get dual: %v, got: %v", tt.dual, got.Dual)
+			}
+
+			values := got.Values()
+			for i, v := range tt.problem.Variables {
+				if values[v] != tt.optimal[i] {
+					t.Fatalf("Expected %s to be: %v, got: %v", v, tt.optimal[i], values[v])
+				}
+			}
+		})
+	}
+}
+
+func TestSolveInterior(t *testing.T) {
+	tc := []struct {
+		name    string
+		problem Problem
+	}{
+		{
+			name: "Should match doubled method for max problem",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+				},
+			},
+		},
+		{
+			name: "Should match doubled method for min problem with bounds",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"a", "b"},
+				Objective: []float64{-1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 0}, Relation: LessEqual, RHS: 3},
+					{Coefficients: []float64{0, 1}, Relation: GreaterEqual, RHS: 2},
+					{Coefficients: []float64{1, 1}, Relation: Equal, RHS: 5},
+				},
+				Bounds: []Bound{NonNegative, {Lower: 1, Upper: 4}},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			expected, err := Solve(context.Background(), tt.problem, inequations.DoubledMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			got, err := Solve(context.Background(), tt.problem, inequations.InteriorPointMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if math.Abs(got.Objective-expected.Objective) > Tolerance {
+				t.Fatalf("Expected to get: %v, got: %v", expected.Objective, got.Objective)
+			}
+
+			for i := range expected.Optimal {
+				if math.Abs(got.Optimal[i]-expected.Optimal[i]) > Tolerance {
+					t.Fatalf("Expected to get: %v, got: %v", expected.Optimal, got.Optimal)
+				}
+			}
+
+			for i := range expected.Dual {
+				if math.Abs(got.Dual[i]-expected.Dual[i]) > Tolerance {
+					t.Fatalf("Expected to get dual: %v, got: %v", expected.Dual, got.Dual)
+				}
+			}
+		})
+	}
+}
+
+func TestSolveBounded(t *testing.T) {
+	tc := []struct {
+		name      string
+		problem   Problem
+		optimal   []float64
+		objective float64
+	}{
+		{
+			name: "Should respect upper bounds",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"a", "b"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+				},
+				Bounds: []Bound{{Lower: 0, Upper: 2}, {Lower: 1, Upper: 1.5}},
+			},
+			optimal:   []float64{2, 1.5},
+			objective: 9,
+		},
+		{
+			name: "Should solve with free variable and equation",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"a", "b"},
+				Objective: []float64{1, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: Equal, RHS: 1},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 5},
+				},
+				Bounds: []Bound{Free, {Lower: 2, Upper: math.Inf(1)}},
+			},
+			optimal:   []float64{-1, 2},
+			objective: 3,
+		},
+		{
+			name: "Should solve with upper bound only",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"a", "b"},
+				Objective: []float64{1, -1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: GreaterEqual, RHS: -3},
+				},
+				Bounds: []Bound{{Lower: math.Inf(-1), Upper: -1}, {Lower: 0, Upper: 4}},
+			},
+			optimal:   []float64{-1, 0},
+			objective: -1,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Solve(context.Background(), tt.problem, inequations.BoundedMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(got.Optimal, tt.optimal) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.optimal, got.Optimal)
+			}
+
+			if math.Abs(got.Objective-tt.objective) > Tolerance {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, got.Objective)
+			}
+
+			bounded, err := tt.problem.CompileBounded()
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if rows := len(bounded.Matrix.Rows); rows != len(tt.problem.Constraints)+1 {
+				t.Fatalf("Expected bounds not to add rows, got: %v rows", rows)
+			}
+
+			if _, err := bounded.Solve(context.Background(), inequations.SimplexMethod); !errors.Is(err, ErrImplicitBounds) {
+				t.Fatalf("Expected to get error: %v, got: %v", ErrImplicitBounds, err)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tc := []struct {
+		name    string
+		problem Problem
+		change  func(t *Tableau) error
+		changed Problem
+	}{
+		{
+			name: "Should add constraint",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+				},
+			},
+			change: func(t *Tableau) error {
+				return t.AddConstraint(Constraint{Coefficients: []float64{1, 0}, Relation: LessEqual, RHS: 2})
+			},
+			changed: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+					{Coefficients: []float64{1, 0}, Relation: LessEqual, RHS: 2},
+				},
+			},
+		},
+		{
+			name: "Should change right hand side of negated row",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: GreaterEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+				},
+			},
+			change: func(t *Tableau) error {
+				return t.ShiftRHS(0, 1)
+			},
+			changed: Problem{
+				Sense:     Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: GreaterEqual, RHS: 5},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+				},
+			},
+		},
+		{
+			name: "Should add and shift constraints of bounded variables",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 5},
+				},
+				Bounds: []Bound{{Lower: 2, Upper: 10}, {Lower: -1, Upper: 1}},
+			},
+			change: func(t *Tableau) error {
+				if err := t.AddConstraint(Constraint{Coefficients: []float64{1, -1}, Relation: GreaterEqual, RHS: 4}); err != nil {
+					return err
+				}
+				return t.ShiftRHS(1, -1)
+			},
+			changed: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 5},
+					{Coefficients: []float64{1, -1}, Relation: GreaterEqual, RHS: 3},
+				},
+				Bounds: []Bound{{Lower: 2, Upper: 10}, {Lower: -1, Upper: 1}},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tableau, err := tt.problem.Compile()
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if _, err := tableau.Solve(context.Background(), inequations.DoubledMethod); err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if err := tt.change(tableau); err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			got, err := tableau.Resolve(context.Background())
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			expected, err := Solve(context.Background(), tt.changed, inequations.DoubledMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(got.Optimal, expected.Optimal) {
+				t.Fatalf("Expected to get: %v, got: %v", expected.Optimal, got.Optimal)
+			}
+
+			if math.Abs(got.Objective-expected.Objective) > Tolerance {
+				t.Fatalf("Expected to get: %v, got: %v", expected.Objective, got.Objective)
+			}
+
+			if !reflect.DeepEqual(got.Dual, expected.Dual) {
+				t.Fatalf("Expected to get dual: %v, got: %v", expected.Dual, got.Dual)
+			}
+
+			resolved, err := tableau.Solve(context.Background(), inequations.DoubledMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(resolved.Optimal, expected.Optimal) {
+				t.Fatalf("Expected solving changed tableau to get: %v, got: %v", expected.Optimal, resolved.Optimal)
+			}
+		})
+	}
+}
+
+func TestResolveNotSolved(t *testing.T) {
+	p := Problem{
+		Sense:       Maximize,
+		Variables:   []string{"x1"},
+		Objective:   []float64{1},
+		Constraints: []Constraint{{Coefficients: []float64{1}, Relation: LessEqual, RHS: 1}},
+	}
+
+	tableau, err := p.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tableau.Resolve(context.Background()); !errors.Is(err, ErrNotSolved) {
+		t.Fatalf("Expected to get: %v, got: %v", ErrNotSolved, err)
+	}
+
+	err = tableau.AddConstraint(Constraint{Coefficients: []float64{1}, Relation: Equal, RHS: 1})
+	if !errors.Is(err, ErrAddedEqual) {
+		t.Fatalf("Expected to get: %v, got: %v", ErrAddedEqual, err)
+	}
+}
+
+func TestFromTableau(t *testing.T) {
+	p := Problem{
+		Sense:     Minimize,
+		Variables: []string{"x1", "x2"},
+		Objective: []float64{3, 2},
+		Constraints: []Constraint{
+			{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+			{Coefficients: []float64{-1, 1}, Relation: LessEqual, RHS: -2},
+			{Coefficients: []float64{2, 1}, Relation: Equal, RHS: 3},
+		},
+	}
+
+	tableau, err := p.Compile()
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	got, err := FromTableau(Minimize, tableau.Matrix)
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, p) {
+		t.Fatalf("Expected to get: %+v, got: %+v", p, got)
+	}
+
+	if _, err := FromTableau(Maximize, matrix.Matrix{Rows: []matrix.Row{{1, 2}}}); err == nil {
+		t.Fatal("Expected to reject tableau without constraints")
+	}
+}
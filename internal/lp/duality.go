@@ -0,0 +1,243 @@
+package lp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/inequations"
+)
+
+// Tolerance absorbs the rounding of the solvers, they round optimal
+// values to two decimals.
+const Tolerance = 1e-2
+
+var (
+	ErrUnsupportedBound = errors.New("dual is defined only for non-negative, non-positive or free variables")
+	ErrDualityViolated  = errors.New("duality check failed")
+)
+
+var NonPositive = Bound{Lower: math.Inf(-1), Upper: 0}
+
+// Dual builds the dual problem. Its variables are named after the primal
+// constraints and equal to their shadow prices, its constraints are
+// named after the primal variables.
+func (p Problem) Dual() (Problem, error) {
+	if err := p.Validate(); err != nil {
+		return Problem{}, err
+	}
+
+	d := Problem{
+		Sense:       Minimize,
+		Variables:   make([]string, len(p.Constraints)),
+		Objective:   make([]float64, len(p.Constraints)),
+		Constraints: make([]Constraint, len(p.Variables)),
+		Bounds:      make([]Bound, len(p.Constraints)),
+	}
+
+	// for a max problem "<=" rows get non-negative prices and
+	// non-negative variables give ">=" rows, min problems are mirrored.
+	natural, opposite := LessEqual, GreaterEqual
+	if p.Sense == Minimize {
+		d.Sense = Maximize
+		natural, opposite = GreaterEqual, LessEqual
+	}
+
+	for i, c := range p.Constraints {
+		d.Variables[i] = p.ConstraintName(i)
+		d.Objective[i] = c.RHS
+		switch c.Relation {
+		case natural:
+			d.Bounds[i] = NonNegative
+		case opposite:
+			d.Bounds[i] = NonPositive
+		default:
+			d.Bounds[i] = Free
+		}
+	}
+
+	for j, v := range p.Variables {
+		c := Constraint{
+			Name:         v,
+			Coefficients: make([]float64, len(p.Constraints)),
+			RHS:          p.Objective[j],
+		}
+
+		for i, row := range p.Constraints {
+			c.Coefficients[i] = row.Coefficients[j]
+		}
+
+		switch p.Bound(j) {
+		case NonNegative:
+			c.Relation = opposite
+		case NonPositive:
+			c.Relation = natural
+		case Free:
+			c.Relation = Equal
+		default:
+			return Problem{}, fmt.Errorf("variable %s: %w", v, ErrUnsupportedBound)
+		}
+
+		d.Constraints[j] = c
+	}
+
+	return d, nil
+}
+
+// CheckDuality verifies that x and y are feasible for the problem and
+// its dual, their objectives are equal and complementary slackness holds.
+// Every failed check is listed in the error.
+func (p Problem) CheckDuality(x, y []float64, tolerance float64) error {
+	d, err := p.Dual()
+	if err != nil {
+		return err
+	}
+
+	if len(x) != len(p.Variables) || len(y) != len(p.Constraints) {
+		return fmt.Errorf(
+			"%w: expected %d primal and %d dual values, got %d and %d",
+			ErrDualityViolated,
+			len(p.Variables),
+			len(p.Constraints),
+			len(x),
+			len(y),
+		)
+	}
+
+	var diagnostics []string
+	for _, v := range p.violations(x, tolerance) {
+		diagnostics = append(diagnostics, "primal "+v)
+	}
+
+	for _, v := range d.violations(y, tolerance) {
+		diagnostics = append(diagnostics, "dual "+v)
+	}
+
+	primal, dual := dot(p.Objective, x), dot(d.Objective, y)
+	if !near(primal, dual, tolerance) {
+		diagnostics = append(diagnostics, fmt.Sprintf("strong duality: primal objective %v, dual objective %v", primal, dual))
+	}
+
+	for i, c := range p.Constraints {
+		slack := c.RHS - dot(c.Coefficients, x)
+		if !near(y[i]*slack, 0, tolerance) {
+			diagnostics = append(diagnostics, fmt.Sprintf(
+				"complementary slackness: constraint %s has slack %v and price %v",
+				p.ConstraintName(i),
+				slack,
+				y[i],
+			))
+		}
+	}
+
+	for j, c := range d.Constraints {
+		reduced := c.RHS - dot(c.Coefficients, y)
+		if !near(x[j]*reduced, 0, tolerance) {
+			diagnostics = append(diagnostics, fmt.Sprintf(
+				"complementary slackness: variable %s is %v with reduced cost %v",
+				p.Variables[j],
+				x[j],
+				reduced,
+			))
+		}
+	}
+
+	if len(diagnostics) > 0 {
+		return fmt.Errorf("%w: %s", ErrDualityViolated, strings.Join(diagnostics, "; "))
+	}
+
+	return nil
+}
+
+// Duality holds both problems with their solutions.
+type Duality struct {
+	Primal         Problem
+	Dual           Problem
+	PrimalSolution *Solution
+	DualSolution   *Solution
+}
+
+// VerifyDuality solves the problem and its dual with the method and
+// checks the pair of solutions, the prices the doubled method reports
+// are checked too. The result is returned with the failed checks as
+// well so the caller can show both.
+func VerifyDuality(ctx context.Context, p Problem, method inequations.Method) (*Duality, error) {
+	d, err := p.Dual()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Duality{Primal: p, Dual: d}
+	if res.PrimalSolution, err = Solve(ctx, p, method); err != nil {
+		return nil, fmt.Errorf("primal: %w", err)
+	}
+
+	if res.DualSolution, err = Solve(ctx, d, method); err != nil {
+		return nil, fmt.Errorf("dual: %w", err)
+	}
+
+	x := res.PrimalSolution.Optimal
+	err = p.CheckDuality(x, res.DualSolution.Optimal, Tolerance)
+	if res.PrimalSolution.Dual != nil {
+		if derr := p.CheckDuality(x, res.PrimalSolution.Dual, Tolerance); derr != nil {
+			err = errors.Join(err, fmt.Errorf("doubled solution: %w", derr))
+		}
+	}
+
+	return res, err
+}
+
+// violations lists the constraints and bounds the values break.
+func (p Problem) violations(values []float64, tolerance float64) []string {
+	var res []string
+	for i, c := range p.Constraints {
+		lhs := dot(c.Coefficients, values)
+		var ok bool
+		switch c.Relation {
+		case LessEqual:
+			ok = lhs <= c.RHS+scale(lhs, c.RHS, tolerance)
+		case GreaterEqual:
+			ok = lhs >= c.RHS-scale(lhs, c.RHS, tolerance)
+		default:
+			ok = near(lhs, c.RHS, tolerance)
+		}
+
+		if !ok {
+			res = append(res, fmt.Sprintf("constraint %s: %v %s %v is violated", p.ConstraintName(i), lhs, c.Relation, c.RHS))
+		}
+	}
+
+	for j, v := range values {
+		b := p.Bound(j)
+		if v < b.Lower-scale(v, b.Lower, tolerance) || v > b.Upper+scale(v, b.Upper, tolerance) {
+			res = append(res, fmt.Sprintf("variable %s = %v is out of [%v; %v]", p.Variables[j], v, b.Lower, b.Upper))
+		}
+	}
+
+	return res
+}
+
+func dot(a, b []float64) float64 {
+	var res float64
+	for i := range a {
+		res += a[i] * b[i]
+	}
+
+	return res
+}
+
+func near(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= scale(a, b, tolerance)
+}
+
+// scale makes the tolerance relative for big values.
+func scale(a, b, tolerance float64) float64 {
+	m := math.Max(math.Abs(a), math.Abs(b))
+	if math.IsInf(m, 0) {
+		return 0
+	}
+
+	return tolerance * math.Max(1, m)
+}
@@ -0,0 +1,256 @@
+package lp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/inequations"
+)
+
+func TestDual(t *testing.T) {
+	tc := []struct {
+		name     string
+		problem  Problem
+		expected Problem
+		err      error
+	}{
+		{
+			name: "Should build dual of max problem",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"a", "b"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Name: "p", Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: GreaterEqual, RHS: 2},
+					{Coefficients: []float64{2, 1}, Relation: Equal, RHS: 5},
+				},
+				Bounds: []Bound{NonNegative, Free},
+			},
+			expected: Problem{
+				Sense:     Minimize,
+				Variables: []string{"p", "c2", "c3"},
+				Objective: []float64{4, 2, 5},
+				Constraints: []Constraint{
+					{Name: "a", Coefficients: []float64{1, 1, 2}, Relation: GreaterEqual, RHS: 3},
+					{Name: "b", Coefficients: []float64{1, -1, 1}, Relation: Equal, RHS: 2},
+				},
+				Bounds: []Bound{NonNegative, NonPositive, Free},
+			},
+		},
+		{
+			name: "Should build dual of min problem",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"a", "b"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: GreaterEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+				},
+				Bounds: []Bound{NonNegative, NonPositive},
+			},
+			expected: Problem{
+				Sense:     Maximize,
+				Variables: []string{"c1", "c2"},
+				Objective: []float64{4, 2},
+				Constraints: []Constraint{
+					{Name: "a", Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 3},
+					{Name: "b", Coefficients: []float64{1, -1}, Relation: GreaterEqual, RHS: 2},
+				},
+				Bounds: []Bound{NonNegative, NonPositive},
+			},
+		},
+		{
+			name: "Should reject boxed variables",
+			problem: Problem{
+				Sense:       Maximize,
+				Variables:   []string{"a"},
+				Objective:   []float64{1},
+				Constraints: []Constraint{{Coefficients: []float64{1}, Relation: LessEqual, RHS: 4}},
+				Bounds:      []Bound{{Lower: 0, Upper: 2}},
+			},
+			err: ErrUnsupportedBound,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.problem.Dual()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("Expected to get: %+v, got: %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCheckDuality(t *testing.T) {
+	p := Problem{
+		Sense:     Maximize,
+		Variables: []string{"x1", "x2"},
+		Objective: []float64{3, 2},
+		Constraints: []Constraint{
+			{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+			{Coefficients: []float64{1, -1}, Relation: LessEqual, RHS: 2},
+		},
+	}
+
+	tc := []struct {
+		name   string
+		x      []float64
+		y      []float64
+		hasErr bool
+	}{
+		{
+			name: "Should accept optimal pair",
+			x:    []float64{3, 1},
+			y:    []float64{2.5, 0.5},
+		},
+		{
+			name: "Should accept rounded pair",
+			x:    []float64{3.001, 0.999},
+			y:    []float64{2.5, 0.5},
+		},
+		{
+			name:   "Should reject infeasible primal",
+			x:      []float64{4, 1},
+			y:      []float64{2.5, 0.5},
+			hasErr: true,
+		},
+		{
+			name:   "Should reject infeasible dual",
+			x:      []float64{3, 1},
+			y:      []float64{1, 0},
+			hasErr: true,
+		},
+		{
+			name:   "Should reject duality gap",
+			x:      []float64{0, 0},
+			y:      []float64{2.5, 0.5},
+			hasErr: true,
+		},
+		{
+			name:   "Should reject broken complementary slackness",
+			x:      []float64{2, 0},
+			y:      []float64{0, 3},
+			hasErr: true,
+		},
+		{
+			name:   "Should reject wrong amount of values",
+			x:      []float64{3},
+			y:      []float64{2.5, 0.5},
+			hasErr: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := p.CheckDuality(tt.x, tt.y, Tolerance)
+			if (err != nil) != tt.hasErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.hasErr, err)
+			}
+
+			if err != nil && !errors.Is(err, ErrDualityViolated) {
+				t.Fatalf("Expected to get error: %v, got: %v", ErrDualityViolated, err)
+			}
+		})
+	}
+}
+
+func TestVerifyDuality(t *testing.T) {
+	tc := []struct {
+		name        string
+		goal        string
+		objective   string
+		constraints []string
+		method      inequations.Method
+		dual        []float64
+		hasErr      bool
+	}{
+		{
+			name:        "Should verify max problem",
+			goal:        "max",
+			objective:   "3x1+2x2",
+			constraints: []string{"x1+x2<=4", "x1-x2<=2"},
+			method:      inequations.DoubledMethod,
+			dual:        []float64{2.5, 0.5},
+		},
+		{
+			name:        "Should verify min problem",
+			goal:        "min",
+			objective:   "3x1+2x2",
+			constraints: []string{"x1+x2>=4", "x1-x2<=2"},
+			method:      inequations.DoubledMethod,
+			dual:        []float64{2, 0},
+		},
+		{
+			name:        "Should verify problem with more constraints than variables",
+			goal:        "max",
+			objective:   "x1+x2",
+			constraints: []string{"x1<=4", "x2<=3", "x1+x2<=6", "x1-x2<=1"},
+			method:      inequations.DoubledMethod,
+			dual:        []float64{0, 0, 1, 0},
+		},
+		{
+			name:        "Should verify equation with simplex method",
+			goal:        "max",
+			objective:   "2x1+3x2",
+			constraints: []string{"x1+x2=4", "x1<=3"},
+			method:      inequations.SimplexMethod,
+			dual:        []float64{3, 0},
+		},
+		{
+			name:        "Should report doubled prices of deleted equations",
+			goal:        "max",
+			objective:   "2x1+3x2",
+			constraints: []string{"x1+x2=4", "x1<=3"},
+			method:      inequations.DoubledMethod,
+			dual:        []float64{3, 0},
+			hasErr:      true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := Parse(tt.goal, tt.objective, tt.constraints)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			got, err := VerifyDuality(context.Background(), p, tt.method)
+			if (err != nil) != tt.hasErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.hasErr, err)
+			}
+
+			if got == nil {
+				t.Fatal("Expected to get both solutions")
+			}
+
+			if !reflect.DeepEqual(got.DualSolution.Optimal, tt.dual) {
+				t.Fatalf("Expected to get dual: %v, got: %v", tt.dual, got.DualSolution.Optimal)
+			}
+
+			if math.Abs(got.PrimalSolution.Objective-got.DualSolution.Objective) > Tolerance {
+				t.Fatalf(
+					"Expected objectives to match, got: %v and %v",
+					got.PrimalSolution.Objective,
+					got.DualSolution.Objective,
+				)
+			}
+		})
+	}
+}
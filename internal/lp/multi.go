@@ -0,0 +1,417 @@
+package lp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/hrvadl/algo/internal/inequations"
+)
+
+var (
+	ErrNoObjectives   = errors.New("problem should have at least one objective")
+	ErrInvalidWeight  = errors.New("weight should be finite and non-negative")
+	ErrNoWeights      = errors.New("at least one objective should have a positive weight")
+	ErrInvalidTarget  = errors.New("target should be finite")
+	ErrNotBiObjective = errors.New("pareto front is enumerated only for two objectives")
+)
+
+// Objective is one of the criteria of a multi-objective problem. Weight
+// scales it in the weighted sum and scales its deviation in the goal
+// programming. Target is the goal: max objectives should reach it, min
+// objectives should not exceed it.
+type Objective struct {
+	Name         string
+	Sense        Sense
+	Coefficients []float64
+	Weight       float64
+	Target       float64
+}
+
+// MultiProblem is a linear program with several objectives over the same
+// variables, constraints and bounds.
+type MultiProblem struct {
+	Variables   []string
+	Objectives  []Objective
+	Constraints []Constraint
+	Bounds      []Bound
+}
+
+// MultiSolution is the solution of the last solved scalar problem with
+// the values of every objective at its optimum. Deviations are filled by
+// the goal programming only and hold how far each goal is missed.
+type MultiSolution struct {
+	Solution
+	Values     []float64
+	Deviations []float64
+}
+
+// NewMultiProblem takes the objective of the problem as the first one,
+// the others are padded to its variables.
+func NewMultiProblem(p Problem, others ...Objective) MultiProblem {
+	mp := MultiProblem{
+		Variables:   p.Variables,
+		Objectives:  make([]Objective, 0, len(others)+1),
+		Constraints: p.Constraints,
+		Bounds:      p.Bounds,
+	}
+
+	mp.Objectives = append(mp.Objectives, Objective{Sense: p.Sense, Coefficients: p.Objective, Weight: 1})
+	for _, o := range others {
+		o.Coefficients = pad(o.Coefficients, len(p.Variables))
+		mp.Objectives = append(mp.Objectives, o)
+	}
+
+	return mp
+}
+
+func (mp MultiProblem) Validate() error {
+	if len(mp.Objectives) == 0 {
+		return ErrNoObjectives
+	}
+
+	if err := mp.problem(Maximize, make([]float64, len(mp.Variables))).Validate(); err != nil {
+		return err
+	}
+
+	for k, o := range mp.Objectives {
+		name := mp.ObjectiveName(k)
+		switch {
+		case o.Sense != Maximize && o.Sense != Minimize:
+			return fmt.Errorf("objective %s: %w", name, ErrInvalidSense)
+		case len(o.Coefficients) != len(mp.Variables):
+			return fmt.Errorf(
+				"objective %s should have %d coefficients, got %d",
+				name,
+				len(mp.Variables),
+				len(o.Coefficients),
+			)
+		case o.Weight < 0 || math.IsInf(o.Weight, 0) || math.IsNaN(o.Weight):
+			return fmt.Errorf("objective %s: %w", name, ErrInvalidWeight)
+		case math.IsInf(o.Target, 0) || math.IsNaN(o.Target):
+			return fmt.Errorf("objective %s: %w", name, ErrInvalidTarget)
+		}
+	}
+
+	return nil
+}
+
+// ObjectiveName returns the name of the k-th objective, unnamed ones are
+// called f1, f2 and so on.
+func (mp MultiProblem) ObjectiveName(k int) string {
+	if name := mp.Objectives[k].Name; name != "" {
+		return name
+	}
+
+	return fmt.Sprintf("f%d", k+1)
+}
+
+// SolveWeighted maximizes the weighted sum of the objectives, min ones
+// are taken with the minus sign. The objective of the solution is the
+// value of that sum.
+func SolveWeighted(ctx context.Context, mp MultiProblem, method inequations.Method) (*MultiSolution, error) {
+	if err := mp.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !slices.ContainsFunc(mp.Objectives, func(o Objective) bool { return o.Weight > 0 }) {
+		return nil, ErrNoWeights
+	}
+
+	z := make([]float64, len(mp.Variables))
+	for _, o := range mp.Objectives {
+		w := o.Weight * orientation(o.Sense)
+		for j, c := range o.Coefficients {
+			z[j] += w * c
+		}
+	}
+
+	return mp.solve(ctx, mp.problem(Maximize, z), method)
+}
+
+// lexicographicSlack relaxes the optimum kept for the next objectives.
+// The interior point method needs a strictly feasible region, so it gets
+// a slack above the precision it converges to.
+const (
+	lexicographicSlack         = 1e-9
+	lexicographicInteriorSlack = 1e-6
+)
+
+// SolveLexicographic optimizes the objectives in their order, the optimum
+// of every objective is kept as a constraint for the next ones.
+func SolveLexicographic(ctx context.Context, mp MultiProblem, method inequations.Method) (*MultiSolution, error) {
+	if err := mp.Validate(); err != nil {
+		return nil, err
+	}
+
+	return mp.lexicographic(ctx, method, mp.Objectives)
+}
+
+func (mp MultiProblem) lexicographic(
+	ctx context.Context,
+	method inequations.Method,
+	order []Objective,
+) (*MultiSolution, error) {
+	p := mp.problem(Maximize, nil)
+	p.Constraints = slices.Clone(p.Constraints)
+
+	var sol *MultiSolution
+	for k, o := range order {
+		p.Sense, p.Objective = o.Sense, o.Coefficients
+
+		var err error
+		if sol, err = mp.solve(ctx, p, method); err != nil {
+			return nil, fmt.Errorf("objective %d: %w", k+1, err)
+		}
+
+		tolerance := lexicographicSlack
+		if method == inequations.InteriorPointMethod {
+			tolerance = lexicographicInteriorSlack
+		}
+
+		value := optimum(sol, o.Sense)
+		slack := scale(value, value, tolerance)
+		fixed := Constraint{
+			Name:         fmt.Sprintf("fixed %d", k+1),
+			Coefficients: o.Coefficients,
+			Relation:     GreaterEqual,
+			RHS:          value - slack,
+		}
+		if o.Sense == Minimize {
+			fixed.Relation = LessEqual
+			fixed.RHS = value + slack
+		}
+		p.Constraints = append(p.Constraints, fixed)
+	}
+
+	return sol, nil
+}
+
+// optimum is the objective of sol read from the last row of its optimal
+// tableau, the simplex methods round the objective to two decimals and a
+// rounded optimum may be out of reach.
+func optimum(sol *MultiSolution, sense Sense) float64 {
+	m := sol.Result.Optimal.Matrix
+	if len(m.Rows) == 0 {
+		return sol.Objective
+	}
+
+	z := m.Rows[len(m.Rows)-1][len(m.Rows[0])-1]
+	if sense == Minimize {
+		z = -z
+	}
+
+	return sol.Objective - sol.Result.Objective + z
+}
+
+// SolveGoals minimizes the weighted deviations from the targets. Every
+// objective becomes the equation f + under - over = target, max objectives
+// are penalized for falling under the target and min ones for going over.
+func SolveGoals(ctx context.Context, mp MultiProblem, method inequations.Method) (*MultiSolution, error) {
+	if err := mp.Validate(); err != nil {
+		return nil, err
+	}
+
+	n, goals := len(mp.Variables), len(mp.Objectives)
+	p := Problem{
+		Sense:       Minimize,
+		Variables:   append(make([]string, 0, n+2*goals), mp.Variables...),
+		Objective:   make([]float64, n+2*goals),
+		Constraints: make([]Constraint, 0, len(mp.Constraints)+goals),
+	}
+
+	for _, c := range mp.Constraints {
+		c.Coefficients = pad(slices.Clone(c.Coefficients), n+2*goals)
+		p.Constraints = append(p.Constraints, c)
+	}
+
+	if len(mp.Bounds) != 0 {
+		p.Bounds = slices.Clone(mp.Bounds)
+	}
+
+	for k, o := range mp.Objectives {
+		name := mp.ObjectiveName(k)
+		under, over := n+2*k, n+2*k+1
+		p.Variables = append(p.Variables, "under "+name, "over "+name)
+		if p.Bounds != nil {
+			p.Bounds = append(p.Bounds, NonNegative, NonNegative)
+		}
+
+		if o.Sense == Maximize {
+			p.Objective[under] = o.Weight
+		} else {
+			p.Objective[over] = o.Weight
+		}
+
+		row := pad(slices.Clone(o.Coefficients), n+2*goals)
+		row[under], row[over] = 1, -1
+		p.Constraints = append(p.Constraints, Constraint{
+			Name:         "goal " + name,
+			Coefficients: row,
+			Relation:     Equal,
+			RHS:          o.Target,
+		})
+	}
+
+	sol, err := mp.solve(ctx, p, method)
+	if err != nil {
+		return nil, err
+	}
+
+	sol.Deviations = make([]float64, goals)
+	for k, o := range mp.Objectives {
+		sol.Deviations[k] = max(0, orientation(o.Sense)*(o.Target-sol.Values[k]))
+	}
+
+	return sol, nil
+}
+
+// ParetoFront enumerates the efficient extreme points of a problem with
+// two objectives by the dichotomic search: it starts with the two
+// lexicographic optima and looks for a point beyond the segment between
+// every pair of neighbours with the weights normal to that segment. The
+// points are ordered from the best first objective to the best second.
+func ParetoFront(ctx context.Context, mp MultiProblem, method inequations.Method) ([]MultiSolution, error) {
+	if err := mp.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(mp.Objectives) != 2 {
+		return nil, ErrNotBiObjective
+	}
+
+	first, second := mp.Objectives[0], mp.Objectives[1]
+	a, err := mp.lexicographic(ctx, method, []Objective{first, second})
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := mp.lexicographic(ctx, method, []Objective{second, first})
+	if err != nil {
+		return nil, err
+	}
+
+	if mp.same(*a, *b) {
+		return []MultiSolution{*a}, nil
+	}
+
+	between, err := mp.between(ctx, method, *a, *b)
+	if err != nil {
+		return nil, err
+	}
+
+	front := make([]MultiSolution, 0, len(between)+2)
+	front = append(front, *a)
+	front = append(front, between...)
+	return append(front, *b), nil
+}
+
+// between looks for the points of the front strictly between a and b.
+func (mp MultiProblem) between(
+	ctx context.Context,
+	method inequations.Method,
+	a, b MultiSolution,
+) ([]MultiSolution, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	fa, fb := mp.oriented(a), mp.oriented(b)
+	w := []float64{fb[1] - fa[1], fa[0] - fb[0]}
+	if w[0] <= 0 || w[1] <= 0 {
+		return nil, nil
+	}
+
+	z := make([]float64, len(mp.Variables))
+	for k, o := range mp.Objectives {
+		for j, c := range o.Coefficients {
+			z[j] += w[k] * orientation(o.Sense) * c
+		}
+	}
+
+	c, err := mp.solve(ctx, mp.problem(Maximize, z), method)
+	if err != nil {
+		return nil, err
+	}
+
+	line := w[0]*fa[0] + w[1]*fa[1]
+	fc := mp.oriented(*c)
+	if got := w[0]*fc[0] + w[1]*fc[1]; got <= line+scale(got, line, Tolerance) || mp.same(*c, a) || mp.same(*c, b) {
+		return nil, nil
+	}
+
+	left, err := mp.between(ctx, method, a, *c)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := mp.between(ctx, method, *c, b)
+	if err != nil {
+		return nil, err
+	}
+
+	res := append(left, *c)
+	return append(res, right...), nil
+}
+
+// solve trims the solution to the variables and constraints of the
+// multi-objective problem and evaluates every objective.
+func (mp MultiProblem) solve(ctx context.Context, p Problem, method inequations.Method) (*MultiSolution, error) {
+	sol, err := Solve(ctx, p, method)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(mp.Variables)
+	sol.Variables, sol.Support, sol.Optimal = sol.Variables[:n], sol.Support[:n], sol.Optimal[:n]
+	if len(sol.Dual) > len(mp.Constraints) {
+		sol.Dual = sol.Dual[:len(mp.Constraints)]
+	}
+
+	res := &MultiSolution{Solution: *sol, Values: make([]float64, len(mp.Objectives))}
+	for k, o := range mp.Objectives {
+		res.Values[k] = dot(o.Coefficients, sol.Optimal)
+	}
+
+	return res, nil
+}
+
+func (mp MultiProblem) problem(sense Sense, objective []float64) Problem {
+	return Problem{
+		Sense:       sense,
+		Variables:   mp.Variables,
+		Objective:   objective,
+		Constraints: mp.Constraints,
+		Bounds:      mp.Bounds,
+	}
+}
+
+// oriented turns the values so that every objective is maximized.
+func (mp MultiProblem) oriented(s MultiSolution) []float64 {
+	res := make([]float64, len(s.Values))
+	for k, v := range s.Values {
+		res[k] = orientation(mp.Objectives[k].Sense) * v
+	}
+
+	return res
+}
+
+func (mp MultiProblem) same(a, b MultiSolution) bool {
+	for k := range a.Values {
+		if !near(a.Values[k], b.Values[k], Tolerance) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func orientation(s Sense) float64 {
+	if s == Minimize {
+		return -1
+	}
+
+	return 1
+}
@@ -0,0 +1,394 @@
+package lp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/inequations"
+)
+
+// tradeoff has the efficient extreme points (5, 0), (4.5, 1.5), (3, 3)
+// and (0, 4) when both of its variables are maximized.
+func tradeoff(objectives ...Objective) MultiProblem {
+	return MultiProblem{
+		Variables:  []string{"x1", "x2"},
+		Objectives: objectives,
+		Constraints: []Constraint{
+			{Coefficients: []float64{1, 3}, Relation: LessEqual, RHS: 12},
+			{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 6},
+			{Coefficients: []float64{3, 1}, Relation: LessEqual, RHS: 15},
+		},
+	}
+}
+
+func TestSolveWeighted(t *testing.T) {
+	tc := []struct {
+		name      string
+		problem   MultiProblem
+		optimal   []float64
+		values    []float64
+		objective float64
+		err       error
+	}{
+		{
+			name: "Should maximize weighted sum",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 0}, Weight: 1},
+				Objective{Sense: Maximize, Coefficients: []float64{0, 1}, Weight: 2},
+			),
+			optimal:   []float64{3, 3},
+			values:    []float64{3, 3},
+			objective: 9,
+		},
+		{
+			name: "Should subtract min objectives",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 1}, Weight: 2},
+				Objective{Sense: Minimize, Coefficients: []float64{0, 1}, Weight: 1},
+			),
+			optimal:   []float64{4.5, 1.5},
+			values:    []float64{6, 1.5},
+			objective: 10.5,
+		},
+		{
+			name: "Should reject zero weights",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 0}},
+				Objective{Sense: Maximize, Coefficients: []float64{0, 1}},
+			),
+			err: ErrNoWeights,
+		},
+		{
+			name: "Should reject negative weight",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 0}, Weight: -1},
+			),
+			err: ErrInvalidWeight,
+		},
+		{
+			name:    "Should reject problem without objectives",
+			problem: tradeoff(),
+			err:     ErrNoObjectives,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SolveWeighted(context.Background(), tt.problem, inequations.SimplexMethod)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			checkMultiSolution(t, *got, tt.optimal, tt.values, Tolerance)
+			if math.Abs(got.Objective-tt.objective) > Tolerance {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, got.Objective)
+			}
+		})
+	}
+}
+
+func TestSolveLexicographic(t *testing.T) {
+	tc := []struct {
+		name    string
+		problem MultiProblem
+		method  inequations.Method
+		optimal []float64
+		values  []float64
+	}{
+		{
+			name: "Should break tie with second objective",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 1}},
+				Objective{Sense: Maximize, Coefficients: []float64{0, 1}},
+			),
+			method:  inequations.SimplexMethod,
+			optimal: []float64{3, 3},
+			values:  []float64{6, 3},
+		},
+		{
+			name: "Should break tie with min objective",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 1}},
+				Objective{Sense: Minimize, Coefficients: []float64{0, 1}},
+			),
+			method:  inequations.DoubledMethod,
+			optimal: []float64{4.5, 1.5},
+			values:  []float64{6, 1.5},
+		},
+		{
+			name: "Should keep optimum of first objective",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 0}},
+				Objective{Sense: Maximize, Coefficients: []float64{0, 1}},
+				Objective{Sense: Minimize, Coefficients: []float64{1, 1}},
+			),
+			method:  inequations.SimplexMethod,
+			optimal: []float64{5, 0},
+			values:  []float64{5, 0, 5},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SolveLexicographic(context.Background(), tt.problem, tt.method)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			checkMultiSolution(t, *got, tt.optimal, tt.values, Tolerance)
+			if len(got.Dual) > len(tt.problem.Constraints) {
+				t.Fatalf("Expected to get at most %d prices, got: %v", len(tt.problem.Constraints), got.Dual)
+			}
+		})
+	}
+}
+
+func TestSolveLexicographicMethods(t *testing.T) {
+	problem := MultiProblem{
+		Variables: []string{"x1", "x2"},
+		Objectives: []Objective{
+			{Sense: Maximize, Coefficients: []float64{1, 1}},
+			{Sense: Maximize, Coefficients: []float64{1, 0}},
+		},
+		Constraints: []Constraint{
+			{Coefficients: []float64{3, 3}, Relation: LessEqual, RHS: 8},
+		},
+	}
+
+	tc := []struct {
+		name    string
+		method  inequations.Method
+		optimal []float64
+		values  []float64
+	}{
+		{
+			name:    "Should keep unrounded optimum with simplex method",
+			method:  inequations.SimplexMethod,
+			optimal: []float64{8. / 3, 0},
+			values:  []float64{8. / 3, 8. / 3},
+		},
+		{
+			name:    "Should keep integer optimum",
+			method:  inequations.IntegerMethod,
+			optimal: []float64{2, 0},
+			values:  []float64{2, 2},
+		},
+		{
+			name:    "Should keep optimum with doubled method",
+			method:  inequations.DoubledMethod,
+			optimal: []float64{8. / 3, 0},
+			values:  []float64{8. / 3, 8. / 3},
+		},
+		{
+			name:    "Should keep optimum with bounded method",
+			method:  inequations.BoundedMethod,
+			optimal: []float64{8. / 3, 0},
+			values:  []float64{8. / 3, 8. / 3},
+		},
+		{
+			name:    "Should keep optimum with interior point method",
+			method:  inequations.InteriorPointMethod,
+			optimal: []float64{8. / 3, 0},
+			values:  []float64{8. / 3, 8. / 3},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SolveLexicographic(context.Background(), problem, tt.method)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			checkMultiSolution(t, *got, tt.optimal, tt.values, Tolerance)
+
+			front, err := ParetoFront(context.Background(), problem, tt.method)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if len(front) != 1 {
+				t.Fatalf("Expected to get single point, got: %v", front)
+			}
+			checkMultiSolution(t, front[0], tt.optimal, tt.values, Tolerance)
+		})
+	}
+}
+
+func TestSolveGoals(t *testing.T) {
+	tc := []struct {
+		name       string
+		problem    MultiProblem
+		optimal    []float64
+		values     []float64
+		deviations []float64
+		objective  float64
+	}{
+		{
+			name: "Should prefer goal with bigger weight",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 0}, Weight: 1, Target: 4},
+				Objective{Sense: Maximize, Coefficients: []float64{0, 1}, Weight: 2, Target: 4},
+			),
+			optimal:    []float64{3, 3},
+			values:     []float64{3, 3},
+			deviations: []float64{1, 1},
+			objective:  3,
+		},
+		{
+			name: "Should reach every goal",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 0}, Weight: 1, Target: 4},
+				Objective{Sense: Minimize, Coefficients: []float64{0, 1}, Weight: 1, Target: 0},
+			),
+			optimal:    []float64{4, 0},
+			values:     []float64{4, 0},
+			deviations: []float64{0, 0},
+			objective:  0,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SolveGoals(context.Background(), tt.problem, inequations.SimplexMethod)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			checkMultiSolution(t, *got, tt.optimal, tt.values, Tolerance)
+			if math.Abs(got.Objective-tt.objective) > Tolerance {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, got.Objective)
+			}
+
+			for k := range tt.deviations {
+				if math.Abs(got.Deviations[k]-tt.deviations[k]) > Tolerance {
+					t.Fatalf("Expected to get: %v, got: %v", tt.deviations, got.Deviations)
+				}
+			}
+		})
+	}
+}
+
+func TestParetoFront(t *testing.T) {
+	tc := []struct {
+		name    string
+		problem MultiProblem
+		front   [][]float64
+		err     error
+	}{
+		{
+			name: "Should enumerate every extreme point",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 0}},
+				Objective{Sense: Maximize, Coefficients: []float64{0, 1}},
+			),
+			front: [][]float64{{5, 0}, {4.5, 1.5}, {3, 3}, {0, 4}},
+		},
+		{
+			name: "Should enumerate front of min objective",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 1}},
+				Objective{Sense: Minimize, Coefficients: []float64{1, 0}},
+			),
+			front: [][]float64{{6, 3}, {4, 0}},
+		},
+		{
+			name: "Should return single point without conflict",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 1}},
+				Objective{Sense: Maximize, Coefficients: []float64{2, 2}},
+			),
+			front: [][]float64{{6, 12}},
+		},
+		{
+			name: "Should reject three objectives",
+			problem: tradeoff(
+				Objective{Sense: Maximize, Coefficients: []float64{1, 0}},
+				Objective{Sense: Maximize, Coefficients: []float64{0, 1}},
+				Objective{Sense: Maximize, Coefficients: []float64{1, 1}},
+			),
+			err: ErrNotBiObjective,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParetoFront(context.Background(), tt.problem, inequations.SimplexMethod)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if len(got) != len(tt.front) {
+				t.Fatalf("Expected to get: %v, got: %v points", tt.front, len(got))
+			}
+
+			for i, point := range got {
+				for k, v := range point.Values {
+					if math.Abs(v-tt.front[i][k]) > Tolerance {
+						t.Fatalf("Expected to get: %v, got: %v at %d", tt.front[i], point.Values, i)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewMultiProblem(t *testing.T) {
+	p, err := Parse("max", "x1+x2", []string{"x1+2x2<=4", "3x1+x2<=6"})
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	second, err := ParseObjective("min x1")
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	mp := NewMultiProblem(p, second)
+	if err := mp.Validate(); err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	if c := mp.Objectives[1].Coefficients; len(c) != 2 || c[0] != 1 || c[1] != 0 {
+		t.Fatalf("Expected to get: %v, got: %v", []float64{1, 0}, c)
+	}
+
+	if name := mp.ObjectiveName(1); name != "f2" {
+		t.Fatalf("Expected to get: %v, got: %v", "f2", name)
+	}
+
+	third, err := ParseObjective("max x1+x2+x3")
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	if err := NewMultiProblem(p, third).Validate(); err == nil {
+		t.Fatal("Expected to get error for unknown variable, got nil")
+	}
+}
+
+func checkMultiSolution(t *testing.T, got MultiSolution, optimal, values []float64, tolerance float64) {
+	t.Helper()
+	for j := range optimal {
+		if math.Abs(got.Optimal[j]-optimal[j]) > tolerance {
+			t.Fatalf("Expected to get: %v, got: %v", optimal, got.Optimal)
+		}
+	}
+
+	for k := range values {
+		if math.Abs(got.Values[k]-values[k]) > tolerance {
+			t.Fatalf("Expected to get: %v, got: %v", values, got.Values)
+		}
+	}
+}
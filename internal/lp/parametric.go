@@ -0,0 +1,108 @@
+package lp
+
+import (
+	"context"
+
+	"github.com/hrvadl/algo/internal/inequations"
+)
+
+// ParametricInterval keeps the optimal basis of the tableau, Solution is
+// mapped back to the problem variables.
+type ParametricInterval = inequations.ParametricInterval
+
+// ParametricRHS solves the problem with the right hand sides
+// RHS + t*direction for t in [from; to], the direction has a value per
+// constraint. Equations aren't supported, the bounds don't move.
+func (t *Tableau) ParametricRHS(
+	ctx context.Context,
+	direction []float64,
+	from, to float64,
+) ([]ParametricInterval, error) {
+	if t.bounds != nil {
+		return nil, ErrImplicitBounds
+	}
+
+	if len(direction) != len(t.problem.Constraints) {
+		return nil, inequations.ErrInvalidDirection
+	}
+
+	rows := make([]float64, len(t.Matrix.Rows)-1)
+	for i, c := range t.problem.Constraints {
+		rows[t.rows[i]] = direction[i]
+		if c.Relation == GreaterEqual {
+			rows[t.rows[i]] *= -1
+		}
+	}
+
+	parametric := inequations.ParametricRHSMax
+	if t.problem.Sense == Minimize {
+		parametric = inequations.ParametricRHSMin
+	}
+
+	intervals, err := parametric(ctx, clone(t.Matrix), rows, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range intervals {
+		intervals[i].Solution = t.decodeAffine(intervals[i].Solution)
+		intervals[i].Objective.Constant += t.constant
+	}
+
+	return intervals, nil
+}
+
+// ParametricObjective solves the problem with the objective
+// Objective + t*direction for t in [from; to], the direction has a value
+// per variable.
+func (t *Tableau) ParametricObjective(
+	ctx context.Context,
+	direction []float64,
+	from, to float64,
+) ([]ParametricInterval, error) {
+	if t.bounds != nil {
+		return nil, ErrImplicitBounds
+	}
+
+	if len(direction) != len(t.problem.Variables) {
+		return nil, inequations.ErrInvalidDirection
+	}
+
+	columns, constant := t.substitute(direction)
+	parametric := inequations.ParametricObjectiveMax
+	if t.problem.Sense == Minimize {
+		parametric = inequations.ParametricObjectiveMin
+	}
+
+	intervals, err := parametric(ctx, clone(t.Matrix), columns, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range intervals {
+		intervals[i].Solution = t.decodeAffine(intervals[i].Solution)
+		intervals[i].Objective.Constant += t.constant
+		intervals[i].Objective.Slope += constant
+	}
+
+	return intervals, nil
+}
+
+// decodeAffine maps the columns to the variables, the shifts only move
+// the constant part.
+func (t *Tableau) decodeAffine(columns []inequations.Affine) []inequations.Affine {
+	constants := make([]float64, len(columns))
+	slopes := make([]float64, len(columns))
+	for j, a := range columns {
+		constants[j], slopes[j] = a.Constant, a.Slope
+	}
+
+	constants = t.Decode(constants)
+	slopes = t.Decode(slopes)
+	res := make([]inequations.Affine, len(constants))
+	for i := range res {
+		res[i] = inequations.Affine{Constant: constants[i], Slope: slopes[i] - t.shifts[i]}
+	}
+
+	return res
+}
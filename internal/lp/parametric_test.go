@@ -0,0 +1,218 @@
+package lp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/inequations"
+)
+
+func TestParametricRHS(t *testing.T) {
+	tc := []struct {
+		name      string
+		problem   Problem
+		direction []float64
+		from      float64
+		to        float64
+		expected  []ParametricInterval
+		err       error
+	}{
+		{
+			name: "Should raise budget",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, 0}, Relation: LessEqual, RHS: 3},
+					{Coefficients: []float64{0, 1}, Relation: LessEqual, RHS: 5},
+				},
+			},
+			direction: []float64{1, 0, 0},
+			from:      0,
+			to:        10,
+			expected: []ParametricInterval{
+				{From: 0, To: 4, Solution: []inequations.Affine{affine(3, 0), affine(1, 1)}, Objective: affine(11, 2)},
+				{From: 4, To: 10, Solution: []inequations.Affine{affine(3, 0), affine(5, 0)}, Objective: affine(19, 0)},
+			},
+		},
+		{
+			name: "Should raise demand with shifted bound",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{1, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: GreaterEqual, RHS: 2},
+				},
+				Bounds: []Bound{{Lower: 1, Upper: math.Inf(1)}, NonNegative},
+			},
+			direction: []float64{1},
+			from:      -1,
+			to:        3,
+			expected: []ParametricInterval{
+				{From: -1, To: 3, Solution: []inequations.Affine{affine(2, 1), affine(0, 0)}, Objective: affine(2, 1)},
+			},
+		},
+		{
+			name: "Should reject direction of wrong size",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1"},
+				Objective: []float64{1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1}, Relation: LessEqual, RHS: 2},
+				},
+			},
+			direction: []float64{1, 1},
+			to:        1,
+			err:       inequations.ErrInvalidDirection,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tableau, err := tt.problem.Compile()
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			got, err := tableau.ParametricRHS(context.Background(), tt.direction, tt.from, tt.to)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			checkIntervals(t, got, tt.expected)
+		})
+	}
+}
+
+func TestParametricObjective(t *testing.T) {
+	tc := []struct {
+		name      string
+		problem   Problem
+		direction []float64
+		from      float64
+		to        float64
+		expected  []ParametricInterval
+	}{
+		{
+			name: "Should raise price of the first variable",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, 0}, Relation: LessEqual, RHS: 3},
+					{Coefficients: []float64{0, 1}, Relation: LessEqual, RHS: 3},
+				},
+			},
+			direction: []float64{1, 0},
+			from:      -2,
+			to:        5,
+			expected: []ParametricInterval{
+				{From: -2, To: -1, Solution: []inequations.Affine{affine(0, 0), affine(3, 0)}, Objective: affine(3, 0)},
+				{From: -1, To: 0, Solution: []inequations.Affine{affine(1, 0), affine(3, 0)}, Objective: affine(4, 1)},
+				{From: 0, To: 5, Solution: []inequations.Affine{affine(3, 0), affine(1, 0)}, Objective: affine(4, 3)},
+			},
+		},
+		{
+			name: "Should raise price of bounded variable",
+			problem: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, 0}, Relation: LessEqual, RHS: 3},
+				},
+				Bounds: []Bound{NonNegative, {Lower: 1, Upper: 3}},
+			},
+			direction: []float64{0, 1},
+			from:      -2,
+			to:        2,
+			expected: []ParametricInterval{
+				{From: -2, To: 0, Solution: []inequations.Affine{affine(3, 0), affine(1, 0)}, Objective: affine(4, 1)},
+				{From: 0, To: 2, Solution: []inequations.Affine{affine(1, 0), affine(3, 0)}, Objective: affine(4, 3)},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tableau, err := tt.problem.Compile()
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			got, err := tableau.ParametricObjective(context.Background(), tt.direction, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			checkIntervals(t, got, tt.expected)
+		})
+	}
+}
+
+func TestParametricBounded(t *testing.T) {
+	p := Problem{
+		Sense:     Maximize,
+		Variables: []string{"x1"},
+		Objective: []float64{1},
+		Constraints: []Constraint{
+			{Coefficients: []float64{1}, Relation: LessEqual, RHS: 2},
+		},
+	}
+
+	tableau, err := p.CompileBounded()
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	if _, err := tableau.ParametricRHS(context.Background(), []float64{1}, 0, 1); !errors.Is(err, ErrImplicitBounds) {
+		t.Fatalf("Expected to get error: %v, got: %v", ErrImplicitBounds, err)
+	}
+
+	if _, err := tableau.ParametricObjective(context.Background(), []float64{1}, 0, 1); !errors.Is(err, ErrImplicitBounds) {
+		t.Fatalf("Expected to get error: %v, got: %v", ErrImplicitBounds, err)
+	}
+}
+
+func checkIntervals(t *testing.T, got, expected []ParametricInterval) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("Expected to get: %v, got: %v", expected, got)
+	}
+
+	for i, iv := range got {
+		e := expected[i]
+		if math.Abs(iv.From-e.From) > Tolerance || math.Abs(iv.To-e.To) > Tolerance {
+			t.Fatalf("Expected to get: [%v; %v], got: [%v; %v]", e.From, e.To, iv.From, iv.To)
+		}
+
+		if !nearAffine(iv.Objective, e.Objective) {
+			t.Fatalf("Expected to get: %v, got: %v on [%v; %v]", e.Objective, iv.Objective, iv.From, iv.To)
+		}
+
+		for j := range e.Solution {
+			if !nearAffine(iv.Solution[j], e.Solution[j]) {
+				t.Fatalf("Expected to get: %v, got: %v on [%v; %v]", e.Solution, iv.Solution, iv.From, iv.To)
+			}
+		}
+	}
+}
+
+func affine(constant, slope float64) inequations.Affine {
+	return inequations.Affine{Constant: constant, Slope: slope}
+}
+
+func nearAffine(a, b inequations.Affine) bool {
+	return near(a.Constant, b.Constant, Tolerance) && near(a.Slope, b.Slope, Tolerance)
+}
@@ -0,0 +1,203 @@
+package lp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/cli/parse"
+)
+
+// Parse builds the problem from the expressions accepted by the parse
+// package, variables are named x1, x2 and so on.
+func Parse(goal, objective string, constraints []string) (Problem, error) {
+	p := Problem{
+		Sense:       Sense(goal),
+		Constraints: make([]Constraint, 0, len(constraints)),
+	}
+
+	if p.Sense != Maximize && p.Sense != Minimize {
+		return Problem{}, ErrInvalidSense
+	}
+
+	z, err := parse.NewEvaluator(objective).EvaluateFromString()
+	if err != nil {
+		return Problem{}, err
+	}
+
+	n := len(z)
+	for _, str := range constraints {
+		c, err := parseConstraint(str)
+		if err != nil {
+			return Problem{}, err
+		}
+
+		n = max(n, len(c.Coefficients))
+		p.Constraints = append(p.Constraints, c)
+	}
+
+	p.Objective = pad(z, n)
+	for i := range p.Constraints {
+		p.Constraints[i].Coefficients = pad(p.Constraints[i].Coefficients, n)
+	}
+
+	p.Variables = make([]string, n)
+	for i := range p.Variables {
+		p.Variables[i] = fmt.Sprintf("x%d", i+1)
+	}
+
+	return p, p.Validate()
+}
+
+// parseConstraint reverts the signs the parse package applies to move
+// everything to the "a*x <= b" form.
+func parseConstraint(str string) (Constraint, error) {
+	row, _, err := parse.EquationOrInequationFromString(str)
+	if err != nil {
+		return Constraint{}, err
+	}
+
+	if len(row) < 2 {
+		return Constraint{}, fmt.Errorf("constraint %q should have variables and a free term", str)
+	}
+
+	n := len(row) - 1
+	c := Constraint{
+		Coefficients: make([]float64, n),
+		Relation:     relationOf(str),
+	}
+
+	sign := -1.
+	if c.Relation == GreaterEqual {
+		sign = 1
+	}
+
+	for i, el := range row[:n] {
+		c.Coefficients[i] = sign * el
+	}
+	c.RHS = -sign * row[n]
+
+	return c, nil
+}
+
+// ParseObjective reads an objective with its goal in front, e.g.
+// "min 2x1+x2". The weight is 1 and the target is 0, the coefficients
+// are padded by NewMultiProblem.
+func ParseObjective(str string) (Objective, error) {
+	goal, expression, _ := strings.Cut(strings.TrimSpace(str), " ")
+	o := Objective{Sense: Sense(goal), Weight: 1}
+	if o.Sense != Maximize && o.Sense != Minimize {
+		return Objective{}, ErrInvalidSense
+	}
+
+	z, err := parse.NewEvaluator(strings.Join(strings.Fields(expression), "")).EvaluateFromString()
+	if err != nil {
+		return Objective{}, err
+	}
+
+	o.Coefficients = z
+	return o, nil
+}
+
+var ErrInvalidBoundSyntax = errors.New("bound should look like x1 <= 5, -2 <= x1 <= 5, x1 = 3 or x1 free")
+
+// ParseBound narrows the bound of one variable, the rest of it is kept:
+// "x1 <= 5" leaves x1 non-negative, while "x1 free" removes both ends.
+func (p *Problem) ParseBound(str string) error {
+	s := strings.Join(strings.Fields(str), "")
+	if name, ok := strings.CutSuffix(s, "free"); ok {
+		return p.setBound(name, func(b *Bound) { *b = Free })
+	}
+
+	relation := relationOf(s)
+	parts := strings.Split(s, string(relation))
+	if relation == GreaterEqual {
+		slices.Reverse(parts)
+	}
+
+	switch {
+	case len(parts) == 3 && relation != Equal:
+		lower, lerr := parseBoundValue(parts[0])
+		upper, uerr := parseBoundValue(parts[2])
+		if lerr != nil || uerr != nil {
+			return ErrInvalidBoundSyntax
+		}
+
+		return p.setBound(parts[1], func(b *Bound) { *b = Bound{Lower: lower, Upper: upper} })
+
+	case len(parts) == 2:
+		if value, err := parseBoundValue(parts[1]); err == nil {
+			return p.setBound(parts[0], func(b *Bound) {
+				b.Upper = value
+				if relation == Equal {
+					b.Lower = value
+				}
+			})
+		}
+
+		if value, err := parseBoundValue(parts[0]); err == nil {
+			return p.setBound(parts[1], func(b *Bound) {
+				b.Lower = value
+				if relation == Equal {
+					b.Upper = value
+				}
+			})
+		}
+	}
+
+	return ErrInvalidBoundSyntax
+}
+
+func (p *Problem) setBound(name string, set func(b *Bound)) error {
+	i := slices.Index(p.Variables, name)
+	if i == -1 {
+		return fmt.Errorf("%w: unknown variable %q", ErrInvalidBoundSyntax, name)
+	}
+
+	if len(p.Bounds) == 0 {
+		p.Bounds = make([]Bound, len(p.Variables))
+		for j := range p.Bounds {
+			p.Bounds[j] = NonNegative
+		}
+	}
+
+	b := p.Bounds[i]
+	set(&b)
+	if err := b.Validate(); err != nil {
+		return fmt.Errorf("variable %s: %w", name, err)
+	}
+
+	p.Bounds[i] = b
+	return nil
+}
+
+func parseBoundValue(str string) (float64, error) {
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil || math.IsNaN(v) {
+		return 0, ErrInvalidBoundSyntax
+	}
+
+	return v, nil
+}
+
+func relationOf(str string) Relation {
+	switch {
+	case strings.ContainsRune(str, '>'):
+		return GreaterEqual
+	case strings.ContainsRune(str, '<'):
+		return LessEqual
+	}
+
+	return Equal
+}
+
+func pad(r []float64, n int) []float64 {
+	if len(r) >= n {
+		return r
+	}
+
+	return append(r, make([]float64, n-len(r))...)
+}
@@ -0,0 +1,216 @@
+package lp
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tc := []struct {
+		name        string
+		goal        string
+		objective   string
+		constraints []string
+		expected    Problem
+		err         error
+		hasErr      bool
+	}{
+		{
+			name:        "Should parse every relation",
+			goal:        "max",
+			objective:   "3x1+2x2",
+			constraints: []string{"x1+x2<=4", "x1-x2>=2", "2x1+x2=3"},
+			expected: Problem{
+				Sense:     Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{3, 2},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+					{Coefficients: []float64{1, -1}, Relation: GreaterEqual, RHS: 2},
+					{Coefficients: []float64{2, 1}, Relation: Equal, RHS: 3},
+				},
+			},
+		},
+		{
+			name:        "Should pad missing variables with zeros",
+			goal:        "min",
+			objective:   "x1",
+			constraints: []string{"x1+x3<=4"},
+			expected: Problem{
+				Sense:     Minimize,
+				Variables: []string{"x1", "x2", "x3"},
+				Objective: []float64{1, 0, 0},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 0, 1}, Relation: LessEqual, RHS: 4},
+				},
+			},
+		},
+		{
+			name:        "Should reject invalid goal",
+			goal:        "avg",
+			objective:   "x1",
+			constraints: []string{"x1<=4"},
+			err:         ErrInvalidSense,
+		},
+		{
+			name:        "Should reject invalid constraint",
+			goal:        "max",
+			objective:   "x1",
+			constraints: []string{"x1<=4<=5"},
+			hasErr:      true,
+		},
+		{
+			name:      "Should reject problem without constraints",
+			goal:      "max",
+			objective: "x1",
+			err:       ErrNoConstraints,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Parse(tt.goal, tt.objective, tt.constraints)
+			if tt.err != nil || tt.hasErr {
+				if err == nil || (tt.err != nil && !errors.Is(err, tt.err)) {
+					t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("Expected to get: %+v, got: %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseBound(t *testing.T) {
+	inf := math.Inf(1)
+	tc := []struct {
+		name     string
+		bounds   []string
+		expected []Bound
+		err      error
+	}{
+		{
+			name:     "Should keep lower bound of upper one",
+			bounds:   []string{"x1 <= 5"},
+			expected: []Bound{{Lower: 0, Upper: 5}, NonNegative},
+		},
+		{
+			name:     "Should parse both ends",
+			bounds:   []string{"-2 <= x2 <= 3", "5 >= x1 >= 1"},
+			expected: []Bound{{Lower: 1, Upper: 5}, {Lower: -2, Upper: 3}},
+		},
+		{
+			name:     "Should parse reversed sides",
+			bounds:   []string{"x1 >= 2", "4 >= x2"},
+			expected: []Bound{{Lower: 2, Upper: inf}, {Lower: 0, Upper: 4}},
+		},
+		{
+			name:     "Should parse free and fixed variables",
+			bounds:   []string{"x1 free", "x2 = 3"},
+			expected: []Bound{Free, {Lower: 3, Upper: 3}},
+		},
+		{
+			name:     "Should narrow free variable",
+			bounds:   []string{"x1 free", "x1 <= -1"},
+			expected: []Bound{{Lower: math.Inf(-1), Upper: -1}, NonNegative},
+		},
+		{
+			name:   "Should reject negative upper bound of non-negative variable",
+			bounds: []string{"x1 <= -1"},
+			err:    ErrInvalidBound,
+		},
+		{
+			name:   "Should reject unknown variable",
+			bounds: []string{"x3 <= 1"},
+			err:    ErrInvalidBoundSyntax,
+		},
+		{
+			name:   "Should reject expression",
+			bounds: []string{"x1 + x2 <= 1"},
+			err:    ErrInvalidBoundSyntax,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := Problem{Variables: []string{"x1", "x2"}}
+			var err error
+			for _, b := range tt.bounds {
+				if err = p.ParseBound(b); err != nil {
+					break
+				}
+			}
+
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err == nil && !reflect.DeepEqual(p.Bounds, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, p.Bounds)
+			}
+		})
+	}
+}
+
+func TestParseObjective(t *testing.T) {
+	tc := []struct {
+		name     string
+		str      string
+		expected Objective
+		err      error
+		hasErr   bool
+	}{
+		{
+			name:     "Should parse max objective",
+			str:      "max 3x1+2x2",
+			expected: Objective{Sense: Maximize, Coefficients: []float64{3, 2}, Weight: 1},
+		},
+		{
+			name:     "Should parse min objective with spaces",
+			str:      "  min x1 - x3 ",
+			expected: Objective{Sense: Minimize, Coefficients: []float64{1, 0, -1}, Weight: 1},
+		},
+		{
+			name: "Should reject missing goal",
+			str:  "3x1+2x2",
+			err:  ErrInvalidSense,
+		},
+		{
+			name:   "Should reject invalid expression",
+			str:    "max 3x1+",
+			hasErr: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseObjective(tt.str)
+			if tt.hasErr {
+				if err == nil {
+					t.Fatal("Expected to get error, got nil")
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
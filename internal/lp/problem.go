@@ -0,0 +1,144 @@
+package lp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hrvadl/algo/internal/inequations"
+)
+
+type Sense string
+
+const (
+	Maximize Sense = "max"
+	Minimize Sense = "min"
+)
+
+type Relation string
+
+const (
+	LessEqual    Relation = "<="
+	GreaterEqual Relation = ">="
+	Equal        Relation = "="
+)
+
+var (
+	ErrInvalidSense    = errors.New("goal should be either min or max")
+	ErrInvalidRelation = errors.New("relation should be one of <=, >= or =")
+	ErrNoVariables     = errors.New("problem should have at least one variable")
+	ErrNoConstraints   = errors.New("problem should have at least one constraint")
+	ErrInvalidBound    = inequations.ErrInvalidBound
+)
+
+// Bound limits a variable to [Lower; Upper], infinite ends are allowed.
+type Bound = inequations.Bound
+
+var (
+	NonNegative = inequations.NonNegative
+	Free        = inequations.Free
+)
+
+type Constraint struct {
+	Name         string
+	Coefficients []float64
+	Relation     Relation
+	RHS          float64
+}
+
+// Problem is a linear program over named variables. Coefficients of the
+// objective and the constraints are aligned with Variables, Bounds are
+// either empty (every variable is non-negative) or aligned as well.
+type Problem struct {
+	Sense       Sense
+	Variables   []string
+	Objective   []float64
+	Constraints []Constraint
+	Bounds      []Bound
+}
+
+// Bound returns the bound of the i-th variable.
+func (p Problem) Bound(i int) Bound {
+	if len(p.Bounds) == 0 {
+		return NonNegative
+	}
+
+	return p.Bounds[i]
+}
+
+// IsNonNegative reports whether every variable is just non-negative, so
+// the compiled tableau has the variables as its columns.
+func (p Problem) IsNonNegative() bool {
+	for i := range p.Variables {
+		if p.Bound(i) != NonNegative {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p Problem) Validate() error {
+	if p.Sense != Maximize && p.Sense != Minimize {
+		return ErrInvalidSense
+	}
+
+	n := len(p.Variables)
+	if n == 0 {
+		return ErrNoVariables
+	}
+
+	if len(p.Constraints) == 0 {
+		return ErrNoConstraints
+	}
+
+	seen := make(map[string]struct{}, n)
+	for _, v := range p.Variables {
+		if _, ok := seen[v]; ok {
+			return fmt.Errorf("variable %s is defined twice", v)
+		}
+		seen[v] = struct{}{}
+	}
+
+	if len(p.Objective) != n {
+		return fmt.Errorf("objective should have %d coefficients, got %d", n, len(p.Objective))
+	}
+
+	for i, c := range p.Constraints {
+		if len(c.Coefficients) != n {
+			return fmt.Errorf(
+				"constraint %s should have %d coefficients, got %d",
+				p.ConstraintName(i),
+				n,
+				len(c.Coefficients),
+			)
+		}
+
+		switch c.Relation {
+		case LessEqual, GreaterEqual, Equal:
+		default:
+			return fmt.Errorf("constraint %s: %w", p.ConstraintName(i), ErrInvalidRelation)
+		}
+	}
+
+	if len(p.Bounds) != 0 && len(p.Bounds) != n {
+		return fmt.Errorf("problem should have %d bounds, got %d", n, len(p.Bounds))
+	}
+
+	for i, b := range p.Bounds {
+		if err := b.Validate(); err != nil {
+			return fmt.Errorf("variable %s: %w", p.Variables[i], err)
+		}
+	}
+
+	return nil
+}
+
+// ConstraintName returns the name of the i-th constraint, unnamed ones
+// are called c1, c2 and so on.
+func (p Problem) ConstraintName(i int) string {
+	if name := p.Constraints[i].Name; name != "" {
+		return name
+	}
+
+	return fmt.Sprintf("c%d", i+1)
+}
@@ -0,0 +1,145 @@
+package lp
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	valid := func() Problem {
+		return Problem{
+			Sense:     Maximize,
+			Variables: []string{"a", "b"},
+			Objective: []float64{1, 2},
+			Constraints: []Constraint{
+				{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 4},
+			},
+		}
+	}
+
+	tc := []struct {
+		name   string
+		modify func(p *Problem)
+		err    error
+		hasErr bool
+	}{
+		{
+			name:   "Should accept valid problem",
+			modify: func(*Problem) {},
+		},
+		{
+			name:   "Should reject unknown sense",
+			modify: func(p *Problem) { p.Sense = "avg" },
+			err:    ErrInvalidSense,
+		},
+		{
+			name:   "Should reject problem without variables",
+			modify: func(p *Problem) { p.Variables = nil },
+			err:    ErrNoVariables,
+		},
+		{
+			name:   "Should reject problem without constraints",
+			modify: func(p *Problem) { p.Constraints = nil },
+			err:    ErrNoConstraints,
+		},
+		{
+			name:   "Should reject duplicated variables",
+			modify: func(p *Problem) { p.Variables[1] = "a" },
+			hasErr: true,
+		},
+		{
+			name:   "Should reject objective of wrong size",
+			modify: func(p *Problem) { p.Objective = []float64{1} },
+			hasErr: true,
+		},
+		{
+			name:   "Should reject constraint of wrong size",
+			modify: func(p *Problem) { p.Constraints[0].Coefficients = []float64{1, 2, 3} },
+			hasErr: true,
+		},
+		{
+			name:   "Should reject unknown relation",
+			modify: func(p *Problem) { p.Constraints[0].Relation = "<" },
+			err:    ErrInvalidRelation,
+		},
+		{
+			name:   "Should reject bounds of wrong size",
+			modify: func(p *Problem) { p.Bounds = []Bound{Free} },
+			hasErr: true,
+		},
+		{
+			name:   "Should reject inverted bound",
+			modify: func(p *Problem) { p.Bounds = []Bound{{Lower: 2, Upper: 1}, NonNegative} },
+			err:    ErrInvalidBound,
+		},
+		{
+			name:   "Should reject infinite lower bound from above",
+			modify: func(p *Problem) { p.Bounds = []Bound{{Lower: math.Inf(1), Upper: math.Inf(1)}, Free} },
+			err:    ErrInvalidBound,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := valid()
+			tt.modify(&p)
+			err := p.Validate()
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if tt.err == nil && (err != nil) != tt.hasErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.hasErr, err)
+			}
+		})
+	}
+}
+
+func TestConstraintName(t *testing.T) {
+	p := Problem{Constraints: []Constraint{{Name: "budget"}, {}}}
+	if got := p.ConstraintName(0); got != "budget" {
+		t.Fatalf("Expected to get: budget, got: %v", got)
+	}
+
+	if got := p.ConstraintName(1); got != "c2" {
+		t.Fatalf("Expected to get: c2, got: %v", got)
+	}
+}
+
+func TestIsNonNegative(t *testing.T) {
+	tc := []struct {
+		name     string
+		bounds   []Bound
+		expected bool
+	}{
+		{
+			name:     "Should treat empty bounds as non-negative",
+			expected: true,
+		},
+		{
+			name:     "Should accept explicit non-negative bounds",
+			bounds:   []Bound{NonNegative, NonNegative},
+			expected: true,
+		},
+		{
+			name:   "Should reject shifted variable",
+			bounds: []Bound{{Lower: 2, Upper: math.Inf(1)}, NonNegative},
+		},
+		{
+			name:   "Should reject free variable",
+			bounds: []Bound{NonNegative, Free},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := Problem{Variables: []string{"x1", "x2"}, Bounds: tt.bounds}
+			if actual := p.IsNonNegative(); actual != tt.expected {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, actual)
+			}
+		})
+	}
+}
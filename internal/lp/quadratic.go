@@ -0,0 +1,55 @@
+package lp
+
+import (
+	"errors"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+var ErrQuadraticSize = errors.New("quadratic matrix should have a row and a column per variable")
+
+// Quadratic rewrites 1/2*x'Qx in terms of the tableau columns y, where
+// x = shift + sign*y, as 1/2*y'Ry + linear*y + constant. R is built from
+// the symmetric part of Q, so R*y is the gradient for any Q.
+func (t *Tableau) Quadratic(q matrix.Matrix) (r matrix.Matrix, linear []float64, constant float64, err error) {
+	n := len(t.problem.Variables)
+	if len(q.Rows) != n {
+		return matrix.Matrix{}, nil, 0, ErrQuadraticSize
+	}
+
+	for _, row := range q.Rows {
+		if len(row) != n {
+			return matrix.Matrix{}, nil, 0, ErrQuadraticSize
+		}
+	}
+
+	shifted := make([]float64, n)
+	for i, row := range q.Rows {
+		shifted[i] = dot(row, t.shifts)
+		constant += t.shifts[i] * shifted[i] / 2
+	}
+
+	r = matrix.Matrix{Rows: make([]matrix.Row, len(t.columns))}
+	linear = make([]float64, len(t.columns))
+	for a, ca := range t.columns {
+		r.Rows[a] = make(matrix.Row, len(t.columns))
+		for b, cb := range t.columns {
+			el := (q.Rows[ca.variable][cb.variable] + q.Rows[cb.variable][ca.variable]) / 2
+			r.Rows[a][b] = ca.sign * cb.sign * el
+		}
+
+		linear[a] = ca.sign * (shifted[ca.variable] + dotColumn(q, ca.variable, t.shifts)) / 2
+	}
+
+	return r, linear, constant, nil
+}
+
+// dotColumn multiplies the j-th column of the matrix by v.
+func dotColumn(q matrix.Matrix, j int, v []float64) float64 {
+	var res float64
+	for i, row := range q.Rows {
+		res += row[j] * v[i]
+	}
+
+	return res
+}
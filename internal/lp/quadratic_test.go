@@ -0,0 +1,102 @@
+package lp
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestQuadratic(t *testing.T) {
+	tc := []struct {
+		name    string
+		problem Problem
+		q       matrix.Matrix
+		points  [][]float64
+		err     error
+	}{
+		{
+			name: "Should shift and split variables",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"x1", "x2", "x3"},
+				Objective: []float64{1, 1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1, 1}, Relation: LessEqual, RHS: 10},
+				},
+				Bounds: []Bound{
+					{Lower: 1, Upper: 4},
+					{Lower: math.Inf(-1), Upper: math.Inf(1)},
+					{Lower: math.Inf(-1), Upper: 2},
+				},
+			},
+			q: matrix.Matrix{Rows: []matrix.Row{
+				{2, 1, 0},
+				{3, 4, -1},
+				{0, -1, 6},
+			}},
+			points: [][]float64{{0, 0, 0, 0}, {1, 2, 0, 3}, {2.5, 0, 1, 0.5}},
+		},
+		{
+			name: "Should reject matrix of wrong size",
+			problem: Problem{
+				Sense:     Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: []float64{1, 1},
+				Constraints: []Constraint{
+					{Coefficients: []float64{1, 1}, Relation: LessEqual, RHS: 1},
+				},
+			},
+			q:   matrix.Matrix{Rows: []matrix.Row{{1}}},
+			err: ErrQuadraticSize,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tableau, err := tt.problem.Compile()
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			r, linear, constant, err := tableau.Quadratic(tt.q)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			for a, row := range r.Rows {
+				for b, el := range row {
+					if el != r.Rows[b][a] {
+						t.Fatalf("Expected to get symmetric matrix, got: %v", r.Rows)
+					}
+				}
+			}
+
+			for _, y := range tt.points {
+				x := tableau.Decode(y)
+				expected := quadratic(tt.q, x)
+				got := quadratic(r, y) + constant
+				for j, l := range linear {
+					got += l * y[j]
+				}
+
+				if !near(got, expected, 1e-9) {
+					t.Fatalf("Expected to get: %v, got: %v at %v", expected, got, y)
+				}
+			}
+		})
+	}
+}
+
+func quadratic(q matrix.Matrix, x []float64) float64 {
+	var res float64
+	for i, row := range q.Rows {
+		for j, el := range row {
+			res += x[i] * el * x[j] / 2
+		}
+	}
+
+	return res
+}
@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -58,47 +59,107 @@ type Matrix struct {
 	TopTitle    []Variable
 }
 
-func (m *Matrix) Rank() int {
-	_, rank, _ := m.SwapAll()
-	return rank
+func (m *Matrix) Rank(ctx context.Context) (int, error) {
+	_, rank, err := m.SwapAll(ctx)
+	return rank, err
 }
 
-func (m *Matrix) SwapAll() (Matrix, int, error) {
+func (m *Matrix) SwapAll(ctx context.Context) (Matrix, int, error) {
 	var rank int
 	resm := *m
 	for i := 0; i < len(resm.Rows) && i < len(resm.Rows[i]); i++ {
+		if err := ctx.Err(); err != nil {
+			return Matrix{}, 0, err
+		}
+
 		var err error
+		before := PivotSnapshot(ctx, resm)
 		eliminated := RoundTo(resm.Rows[i][i], 2)
 		if resm, err = resm.JordanEliminate(i, i); err == nil {
-			fmt.Printf("\nStep #%v. Element: %v. Results: \n", i+1, eliminated)
-			rounded := resm.Round()
-			rounded.Print()
+			NotifyPivot(ctx, SwapStage, i, i, before, resm)
+			PrintStep(ctx, resm, "\nStep #%v. Element: %v. Results: \n", i+1, eliminated)
 			rank++
 		}
 	}
 	return resm, rank, nil
 }
 
-func (m *Matrix) Invert() (Matrix, error) {
+func (m *Matrix) Invert(ctx context.Context) (Matrix, error) {
 	if !m.IsSquare() {
 		return Matrix{}, errors.New("cannot inverse not square matrix")
 	}
 
-	if m.IsDegenerate() {
+	singular, err := m.singular(ctx)
+	if err != nil {
+		return Matrix{}, err
+	}
+
+	if singular {
 		return Matrix{}, errors.New("cannot inverse degenerate matrix")
 	}
 
 	resm := *m
 	for i := range m.Rows {
+		if err := ctx.Err(); err != nil {
+			return Matrix{}, err
+		}
+
+		before := PivotSnapshot(ctx, resm)
 		var err error
 		if resm, err = resm.JordanEliminate(i, i); err != nil {
 			return resm, err
 		}
+
+		NotifyPivot(ctx, InvertStage, i, i, before, resm)
 	}
 
 	return resm, nil
 }
 
+// singularPivot is the share of the biggest element of the matrix below
+// which the pivot of the elimination counts as zero.
+const singularPivot = 1e-12
+
+// singular runs the Gaussian elimination with the partial pivoting on
+// the copy of the square matrix. Unlike the cofactor expansion of the
+// determinant it takes cubic time, so it suits the big matrices.
+func (m *Matrix) singular(ctx context.Context) (bool, error) {
+	rows := m.Copy().Rows
+	var scale float64
+	for _, row := range rows {
+		for _, el := range row {
+			scale = max(scale, math.Abs(el))
+		}
+	}
+
+	for col := range rows {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		pivot := col
+		for i := col + 1; i < len(rows); i++ {
+			if math.Abs(rows[i][col]) > math.Abs(rows[pivot][col]) {
+				pivot = i
+			}
+		}
+
+		if math.Abs(rows[pivot][col]) <= singularPivot*scale || scale == 0 {
+			return true, nil
+		}
+
+		rows[col], rows[pivot] = rows[pivot], rows[col]
+		for i := col + 1; i < len(rows); i++ {
+			factor := rows[i][col] / rows[col][col]
+			for j := col; j < len(rows[i]); j++ {
+				rows[i][j] -= factor * rows[col][j]
+			}
+		}
+	}
+
+	return false, nil
+}
+
 func (m *Matrix) JordanEliminate(col, row int) (Matrix, error) {
 	resm := m.Copy()
 	eliminated := resm.Rows[row][col]
@@ -161,16 +222,25 @@ func (m *Matrix) IsSquare() bool {
 }
 
 func (m *Matrix) Determinant() float64 {
+	det, _ := m.determinant(context.Background())
+	return det
+}
+
+func (m *Matrix) determinant(ctx context.Context) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
 	if !m.IsSquare() {
-		return 0
+		return 0, nil
 	}
 
 	if len(m.Rows) < 1 {
-		return 0
+		return 0, nil
 	}
 
 	if len(m.Rows) == 2 {
-		return m.Rows[0][0]*m.Rows[1][1] - m.Rows[0][1]*m.Rows[1][0]
+		return m.Rows[0][0]*m.Rows[1][1] - m.Rows[0][1]*m.Rows[1][0], nil
 	}
 
 	var (
@@ -182,10 +252,14 @@ func (m *Matrix) Determinant() float64 {
 		coef := m.Rows[row][col]
 		sign := m.GetSignForAlgebraicAddition(col, row)
 		minor := m.MinorFor(col, row)
-		det += coef * sign * minor.Determinant()
+		minorDet, err := minor.determinant(ctx)
+		if err != nil {
+			return 0, err
+		}
+		det += coef * sign * minorDet
 	}
 
-	return det
+	return det, nil
 }
 
 func (m *Matrix) GetDimensions() (col, rows int) {
@@ -372,7 +446,6 @@ func (m *Matrix) FirstNegativeRowInLastColumn() (int, error) {
 
 func (m *Matrix) FirstNegativeColumnInLastRow() (int, error) {
 	lastRow := len(m.Rows) - 1
-	fmt.Printf("\nFinding the optimal solution...\n")
 	for i := 0; i < len(m.Rows[lastRow])-1; i++ {
 		if m.Rows[lastRow][i] < 0 {
 			return i, nil
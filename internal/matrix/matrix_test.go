@@ -1,6 +1,8 @@
 package matrix
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"slices"
 	"testing"
@@ -93,7 +95,7 @@ func TestInvertMatrix(t *testing.T) {
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			actual, _ := tt.m.Invert()
+			actual, _ := tt.m.Invert(context.Background())
 			if actual := actual.Round(); !reflect.DeepEqual(actual.Rows, tt.expected.Rows) {
 				t.Errorf("expected %v, got %v", tt.expected, actual)
 			}
@@ -101,6 +103,59 @@ func TestInvertMatrix(t *testing.T) {
 	}
 }
 
+func TestInvertDegenerateMatrix(t *testing.T) {
+	big := Matrix{Rows: make([]Row, 40)}
+	for i := range big.Rows {
+		big.Rows[i] = make(Row, 40)
+		for j := range big.Rows[i] {
+			big.Rows[i][j] = float64((i*7+j*3)%11 + 1)
+		}
+	}
+	big.Rows[39] = big.Rows[0]
+
+	tc := []struct {
+		name       string
+		m          Matrix
+		degenerate bool
+	}{
+		{
+			name:       "Should reject big degenerate matrix in cubic time",
+			m:          big,
+			degenerate: true,
+		},
+		{
+			name: "Should reject degenerate matrix",
+			m: Matrix{
+				Rows: []Row{
+					{1, 2, 3},
+					{2, 4, 6},
+					{1, 0, 1},
+				},
+			},
+			degenerate: true,
+		},
+		{
+			name: "Should invert matrix of small elements",
+			m: Matrix{
+				Rows: []Row{
+					{1e-20, 0},
+					{0, 2e-20},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := tt.m.Invert(context.Background())
+			if degenerate := err != nil; degenerate != tt.degenerate {
+				t.Fatalf("Expected to get degenerate: %v, got: %v", tt.degenerate, err)
+			}
+		})
+	}
+}
+
 func TestJordanElimination(t *testing.T) {
 	tc := []struct {
 		name     string
@@ -222,7 +277,7 @@ func TestCalculateRank(t *testing.T) {
 
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
-			if actual := tt.m.Rank(); actual != tt.expected {
+			if actual, _ := tt.m.Rank(context.Background()); actual != tt.expected {
 				t.Errorf("expected %v, got %v", tt.expected, actual)
 			}
 		})
@@ -731,3 +786,25 @@ func TestSumRows(t *testing.T) {
 		})
 	}
 }
+
+func TestCancelledElimination(t *testing.T) {
+	m := Matrix{
+		Rows: []Row{
+			{1, 4, 3, 2},
+			{3, 2, 1, 1},
+			{1, 4, 2, -3},
+			{5, 2, -1, 0},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := m.Invert(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get: %v, got: %v", context.Canceled, err)
+	}
+
+	if _, err := m.Rank(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get: %v, got: %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,138 @@
+package matrix
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+type Stage string
+
+const (
+	SwapStage   Stage = "swap"
+	InvertStage Stage = "invert"
+)
+
+// PivotStep describes one Jordan elimination. Before holds the matrix
+// with the pivot at (Row, Col), After is the result of the elimination.
+type PivotStep struct {
+	Stage  Stage
+	Row    int
+	Col    int
+	Before Matrix
+	After  Matrix
+}
+
+func (s PivotStep) Pivot() float64 {
+	return s.Before.Rows[s.Row][s.Col]
+}
+
+type PivotObserver func(PivotStep)
+
+type pivotObserverKey struct{}
+
+// WithPivotObserver returns ctx which reports every pivot made by the
+// solvers to o, observers set by the parent contexts are still called.
+func WithPivotObserver(ctx context.Context, o PivotObserver) context.Context {
+	if parent := pivotObserverFrom(ctx); parent != nil {
+		next := o
+		o = func(s PivotStep) {
+			parent(s)
+			next(s)
+		}
+	}
+
+	return context.WithValue(ctx, pivotObserverKey{}, o)
+}
+
+func pivotObserverFrom(ctx context.Context) PivotObserver {
+	o, _ := ctx.Value(pivotObserverKey{}).(PivotObserver)
+	return o
+}
+
+// PivotSnapshot copies m before the elimination when somebody observes
+// pivots, titles are cloned because eliminations swap them in place.
+func PivotSnapshot(ctx context.Context, m Matrix) Matrix {
+	if pivotObserverFrom(ctx) == nil {
+		return Matrix{}
+	}
+
+	return snapshot(m)
+}
+
+func NotifyPivot(ctx context.Context, stage Stage, row, col int, before, after Matrix) {
+	if o := pivotObserverFrom(ctx); o != nil {
+		o(PivotStep{
+			Stage:  stage,
+			Row:    row,
+			Col:    col,
+			Before: before,
+			After:  snapshot(after),
+		})
+	}
+}
+
+func snapshot(m Matrix) Matrix {
+	c := m.Copy()
+	c.LeftTitle = slices.Clone(m.LeftTitle)
+	c.TopTitle = slices.Clone(m.TopTitle)
+	return c
+}
+
+// Recorder keeps every observed pivot step in order.
+type Recorder struct {
+	mu    sync.Mutex
+	steps []PivotStep
+}
+
+// Record returns ctx which appends every pivot to the returned Recorder.
+func Record(ctx context.Context) (context.Context, *Recorder) {
+	r := &Recorder{}
+	return WithPivotObserver(ctx, r.Observe), r
+}
+
+func (r *Recorder) Observe(s PivotStep) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.steps = append(r.steps, s)
+}
+
+func (r *Recorder) Steps() []PivotStep {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.steps)
+}
+
+type quietKey struct{}
+
+// Quiet returns ctx in which the solvers don't print their steps to the
+// stdout. The servers use it, as the steps of concurrent requests would
+// interleave there.
+func Quiet(ctx context.Context) context.Context {
+	return context.WithValue(ctx, quietKey{}, true)
+}
+
+func IsQuiet(ctx context.Context) bool {
+	quiet, _ := ctx.Value(quietKey{}).(bool)
+	return quiet
+}
+
+// Printf prints to the stdout unless ctx is quiet.
+func Printf(ctx context.Context, format string, args ...any) {
+	if !IsQuiet(ctx) {
+		fmt.Printf(format, args...)
+	}
+}
+
+// PrintStep prints the title and m rounded the way the solvers print
+// their pivots unless ctx is quiet.
+func PrintStep(ctx context.Context, m Matrix, format string, args ...any) {
+	if IsQuiet(ctx) {
+		return
+	}
+
+	fmt.Printf(format, args...)
+	rm := m.Round()
+	rm.Print()
+}
@@ -0,0 +1,121 @@
+package matrix
+
+import (
+	"context"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestRecord(t *testing.T) {
+	tc := []struct {
+		name  string
+		run   func(ctx context.Context, m Matrix) (Matrix, error)
+		stage Stage
+	}{
+		{
+			name: "Should record inverse steps",
+			run: func(ctx context.Context, m Matrix) (Matrix, error) {
+				return m.Invert(ctx)
+			},
+			stage: InvertStage,
+		},
+		{
+			name: "Should record swap steps",
+			run: func(ctx context.Context, m Matrix) (Matrix, error) {
+				res, _, err := m.SwapAll(ctx)
+				return res, err
+			},
+			stage: SwapStage,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := Matrix{
+				Rows: []Row{
+					{2, 1, 0},
+					{1, 3, 1},
+					{0, 1, 4},
+				},
+			}
+
+			ctx, recorder := Record(context.Background())
+			res, err := tt.run(ctx, m)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			steps := recorder.Steps()
+			if len(steps) != len(m.Rows) {
+				t.Fatalf("Expected to get: %d steps, got: %d", len(m.Rows), len(steps))
+			}
+
+			for i, s := range steps {
+				if s.Stage != tt.stage || s.Row != i || s.Col != i {
+					t.Fatalf("Expected step %d to pivot at (%d,%d) on %s, got: %+v", i, i, i, tt.stage, s)
+				}
+
+				if i > 0 && !reflect.DeepEqual(s.Before, steps[i-1].After) {
+					t.Fatalf("Expected step %d to start from the previous result", i)
+				}
+			}
+
+			if !reflect.DeepEqual(steps[0].Before.Rows, m.Rows) {
+				t.Fatalf("Expected first step to start from the input matrix")
+			}
+
+			if last := steps[len(steps)-1].After; !reflect.DeepEqual(last.Rows, res.Rows) {
+				t.Fatalf("Expected last step to produce the result")
+			}
+		})
+	}
+}
+
+func TestQuiet(t *testing.T) {
+	m := Matrix{Rows: []Row{{1, 2}, {3, 4}}}
+	tc := []struct {
+		name    string
+		ctx     context.Context
+		printed bool
+	}{
+		{
+			name:    "Should print steps by default",
+			ctx:     context.Background(),
+			printed: true,
+		},
+		{
+			name: "Should not print steps of quiet context",
+			ctx:  Quiet(context.Background()),
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, err := os.Pipe()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			stdout := os.Stdout
+			os.Stdout = w
+			_, _, err = m.SwapAll(tt.ctx)
+			os.Stdout = stdout
+			_ = w.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			out, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if printed := len(out) != 0; printed != tt.printed {
+				t.Fatalf("Expected to get printed: %v, got: %q", tt.printed, out)
+			}
+		})
+	}
+}
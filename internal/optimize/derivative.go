@@ -0,0 +1,65 @@
+package optimize
+
+import (
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+var (
+	// gradientStep and hessianStep balance the truncation error of the
+	// central differences against the rounding one.
+	gradientStep = math.Cbrt(2.2e-16)
+	hessianStep  = math.Pow(2.2e-16, 0.25)
+)
+
+// Gradient is the central difference approximation of the gradient.
+func Gradient(f Func, x []float64) []float64 {
+	res := make([]float64, len(x))
+	point := make([]float64, len(x))
+	copy(point, x)
+	for i, xi := range x {
+		h := gradientStep * max(1, math.Abs(xi))
+		point[i] = xi + h
+		forward := f(point)
+		point[i] = xi - h
+		backward := f(point)
+		point[i] = xi
+		res[i] = (forward - backward) / (2 * h)
+	}
+
+	return res
+}
+
+// Hessian is the central difference approximation of the matrix of the
+// second derivatives, it's symmetric by construction.
+func Hessian(f Func, x []float64) matrix.Matrix {
+	n := len(x)
+	res := matrix.Matrix{Rows: make([]matrix.Row, n)}
+	for i := range res.Rows {
+		res.Rows[i] = make(matrix.Row, n)
+	}
+
+	point := make([]float64, n)
+	copy(point, x)
+	at := func(i int, hi float64, j int, hj float64) float64 {
+		point[i] += hi
+		point[j] += hj
+		v := f(point)
+		point[i], point[j] = x[i], x[j]
+		return v
+	}
+
+	center := f(x)
+	for i := range n {
+		hi := hessianStep * max(1, math.Abs(x[i]))
+		res.Rows[i][i] = (at(i, hi, i, 0) - 2*center + at(i, -hi, i, 0)) / (hi * hi)
+		for j := range i {
+			hj := hessianStep * max(1, math.Abs(x[j]))
+			d := (at(i, hi, j, hj) - at(i, hi, j, -hj) - at(i, -hi, j, hj) + at(i, -hi, j, -hj)) / (4 * hi * hj)
+			res.Rows[i][j], res.Rows[j][i] = d, d
+		}
+	}
+
+	return res
+}
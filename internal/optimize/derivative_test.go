@@ -0,0 +1,84 @@
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestGradient(t *testing.T) {
+	tc := []struct {
+		name     string
+		f        Func
+		x        []float64
+		expected []float64
+	}{
+		{
+			name:     "Should differentiate quadratic function",
+			f:        func(x []float64) float64 { return x[0]*x[0] + 3*x[0]*x[1] },
+			x:        []float64{1, 2},
+			expected: []float64{8, 3},
+		},
+		{
+			name:     "Should differentiate exponent far from origin",
+			f:        func(x []float64) float64 { return math.Exp(x[0] / 100) },
+			x:        []float64{200},
+			expected: []float64{math.Exp(2) / 100},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Gradient(tt.f, tt.x)
+			for i := range tt.expected {
+				if math.Abs(got[i]-tt.expected[i]) > 1e-6 {
+					t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestHessian(t *testing.T) {
+	tc := []struct {
+		name     string
+		f        Func
+		x        []float64
+		expected matrix.Matrix
+	}{
+		{
+			name: "Should differentiate quadratic function",
+			f:    func(x []float64) float64 { return x[0]*x[0] + 3*x[0]*x[1] - 2*x[1]*x[1] },
+			x:    []float64{1, 2},
+			expected: matrix.Matrix{Rows: []matrix.Row{
+				{2, 3},
+				{3, -4},
+			}},
+		},
+		{
+			name: "Should differentiate product of sine and square",
+			f:    func(x []float64) float64 { return math.Sin(x[0]) * x[1] * x[1] },
+			x:    []float64{0, 3},
+			expected: matrix.Matrix{Rows: []matrix.Row{
+				{0, 6},
+				{6, 0},
+			}},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Hessian(tt.f, tt.x)
+			for i, row := range tt.expected.Rows {
+				for j, el := range row {
+					if math.Abs(got.Rows[i][j]-el) > 1e-4 {
+						t.Fatalf("Expected to get: %v, got: %v", tt.expected.Rows, got.Rows)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,587 @@
+package optimize
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+type Method uint8
+
+const (
+	GradientDescent Method = iota
+	NewtonMethod
+	PenaltyMethod
+	LagrangeMethod
+)
+
+const (
+	// Tolerance is the norm of the gradient the methods stop at.
+	Tolerance     = 1e-6
+	MaxIterations = 1000
+
+	// penaltyTolerance is the constraint violation the penalty method
+	// stops at. It's looser, as closer to the border the differences
+	// step over the kink of the penalty and the multipliers get lost.
+	penaltyTolerance = 1e-4
+
+	maxPenaltyIterations = 20
+	penaltyGrowth        = 10
+
+	// armijo is the share of the linear decrease the line search asks for.
+	armijo        = 1e-4
+	maxHalvings   = 60
+	singularPivot = 1e-12
+
+	// curvatureTolerance is the error of the Hessian the second order
+	// condition allows, it's approximated by the differences.
+	curvatureTolerance = 1e-6
+
+	// dependentGradient is the norm of the part of a gradient left after
+	// the projection, below it the gradient is a combination of others.
+	dependentGradient = 1e-8
+)
+
+var (
+	ErrUnknownMethod = errors.New("unknown optimization method")
+	ErrConstrained   = errors.New("method solves only unconstrained problems, use penalty or lagrange method")
+	ErrInequality    = errors.New("lagrange method solves only equality constraints, use penalty method")
+	ErrNotConverged  = errors.New("method has not converged, the problem may be unbounded")
+	ErrSingular      = errors.New("lagrange system is singular, try another start point")
+	ErrNotOptimal    = errors.New("lagrange method found a stationary point which is not the optimum, try another start point")
+)
+
+// Iteration is a point of the trajectory. Norm is the norm of the
+// gradient, of the Lagrange system for the Lagrange method and of the
+// constraint violation for the penalty one, which also keeps its weight.
+type Iteration struct {
+	Step        int
+	X           []float64
+	Value       float64
+	Norm        float64
+	Penalty     float64
+	Multipliers []float64
+}
+
+// Result keeps the whole trajectory of the method. Multipliers are the
+// derivatives of the optimum by the right hand sides of the constraints,
+// only the penalty and the Lagrange methods find them.
+type Result struct {
+	Variables   []string
+	Optimal     []float64
+	Objective   float64
+	Multipliers []float64
+	Trajectory  []Iteration
+}
+
+func Solve(ctx context.Context, p Problem, method Method) (*Result, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	res := &Result{Variables: p.Variables}
+	var err error
+	switch method {
+	case GradientDescent, NewtonMethod:
+		if len(p.Constraints) != 0 {
+			return nil, ErrConstrained
+		}
+
+		res.Optimal, err = descend(ctx, p.minimized(), p.start(), method == NewtonMethod, res.trace(ctx, p))
+	case PenaltyMethod:
+		res.Optimal, res.Multipliers, err = penalty(ctx, p, res.trace(ctx, p))
+	case LagrangeMethod:
+		res.Optimal, res.Multipliers, err = lagrange(ctx, p, res.trace(ctx, p))
+	default:
+		return nil, ErrUnknownMethod
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.Objective = p.Objective(res.Optimal)
+	return res, nil
+}
+
+type visitor func(it Iteration, hessian *matrix.Matrix)
+
+// trace records the iteration and prints it the way the solvers print
+// their pivots.
+func (r *Result) trace(ctx context.Context, p Problem) visitor {
+	return func(it Iteration, hessian *matrix.Matrix) {
+		it.X = slices.Clone(it.X)
+		it.Multipliers = slices.Clone(it.Multipliers)
+		it.Value = p.Objective(it.X)
+		r.Trajectory = append(r.Trajectory, it)
+
+		matrix.Printf(
+			ctx,
+			"\nIteration %v, x: %v, f: %v, norm: %v",
+			it.Step,
+			matrix.RoundRowTo(it.X, 4),
+			matrix.RoundTo(it.Value, 4),
+			matrix.RoundTo(it.Norm, 6),
+		)
+
+		if it.Penalty != 0 {
+			matrix.Printf(ctx, ", penalty: %v", it.Penalty)
+		}
+
+		if it.Multipliers != nil {
+			matrix.Printf(ctx, ", multipliers: %v", matrix.RoundRowTo(it.Multipliers, 4))
+		}
+
+		if hessian == nil {
+			matrix.Printf(ctx, "\n")
+			return
+		}
+
+		matrix.PrintStep(ctx, *hessian, ", Hessian:\n\n")
+	}
+}
+
+// descend minimizes f with the backtracking line search along the anti
+// gradient or, when newton is set and the Hessian gives the descent
+// direction, along the Newton one.
+func descend(ctx context.Context, f Func, x []float64, newton bool, visit visitor) ([]float64, error) {
+	step := 1.
+	for k := range MaxIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		value := f(x)
+		if math.IsInf(value, 0) || math.IsNaN(value) {
+			return nil, ErrNotConverged
+		}
+
+		g := Gradient(f, x)
+		direction := scaled(g, -1)
+
+		var hessian *matrix.Matrix
+		if newton {
+			h := Hessian(f, x)
+			hessian = &h
+			if d, ok := solveLinear(h, direction); ok && dot(d, g) < 0 {
+				direction, step = d, 1
+			}
+		}
+
+		if visit != nil {
+			visit(Iteration{Step: k, X: x, Norm: norm(g)}, hessian)
+		}
+
+		if norm(g) <= Tolerance {
+			return x, nil
+		}
+
+		var ok bool
+		if x, step, ok = lineSearch(f, x, value, g, direction, step); !ok {
+			// nothing decreases f along the direction anymore, the
+			// rounding errors of the differences are left
+			return x, nil
+		}
+
+		if !newton {
+			step *= 2
+		}
+	}
+
+	return nil, ErrNotConverged
+}
+
+func lineSearch(f Func, x []float64, value float64, g, d []float64, step float64) ([]float64, float64, bool) {
+	slope := dot(g, d)
+	for range maxHalvings {
+		next := added(x, d, step)
+		if f(next) <= value+armijo*step*slope {
+			return next, step, true
+		}
+		step /= 2
+	}
+
+	return x, step, false
+}
+
+// penalty minimizes the objective plus the weighted squares of the
+// constraint violations raising the weight until they vanish.
+func penalty(ctx context.Context, p Problem, visit visitor) ([]float64, []float64, error) {
+	f := p.minimized()
+	sign := 1.
+	if p.Sense == lp.Maximize {
+		sign = -1
+	}
+
+	x := p.start()
+	weight := 1.
+	for k := range maxPenaltyIterations {
+		penalized := func(x []float64) float64 {
+			res := f(x)
+			for _, c := range p.Constraints {
+				v := violation(c, x)
+				res += weight * v * v
+			}
+			return res
+		}
+
+		var err error
+		if x, err = descend(ctx, penalized, x, true, nil); err != nil {
+			return nil, nil, err
+		}
+
+		multipliers := make([]float64, len(p.Constraints))
+		var worst float64
+		for i, c := range p.Constraints {
+			v := violation(c, x)
+			worst = max(worst, math.Abs(v))
+			multipliers[i] = -2 * weight * sign * v
+		}
+
+		visit(Iteration{Step: k, X: x, Norm: worst, Penalty: weight, Multipliers: multipliers}, nil)
+		if worst <= penaltyTolerance {
+			return x, multipliers, nil
+		}
+
+		weight *= penaltyGrowth
+	}
+
+	return nil, nil, ErrNotConverged
+}
+
+// violation is signed: positive for the broken "<=" and "=" constraints,
+// negative for the broken ">=" and "=" ones, 0 for the satisfied.
+func violation(c Constraint, x []float64) float64 {
+	v := c.Function(x)
+	switch c.Relation {
+	case lp.LessEqual:
+		return max(v, 0)
+	case lp.GreaterEqual:
+		return min(v, 0)
+	}
+
+	return v
+}
+
+// lagrange solves grad f = sum(l_i * grad g_i), g = 0 for x and the
+// multipliers l with the Newton's method, the norm of the system is
+// decreased by the line search. The multipliers start as the least
+// squares solution at the start point. Where the system is singular the
+// constraints are degenerate, so the penalty method moves the point
+// once. The stationary point found is checked to be the optimum on the
+// tangent space of the constraints.
+func lagrange(ctx context.Context, p Problem, visit visitor) ([]float64, []float64, error) {
+	for _, c := range p.Constraints {
+		if c.Relation != lp.Equal {
+			return nil, nil, ErrInequality
+		}
+	}
+
+	var step int
+	numbered := func(it Iteration, hessian *matrix.Matrix) {
+		it.Step = step
+		step++
+		visit(it, hessian)
+	}
+
+	n, m := len(p.Variables), len(p.Constraints)
+	x := p.start()
+	z := append(x, leastSquaresMultipliers(p, x)...)
+	moved := false
+	for range MaxIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		residual := lagrangeResidual(p, z)
+		hessian := Hessian(lagrangian(p, z[n:]), z[:n])
+		numbered(Iteration{X: z[:n], Norm: norm(residual), Multipliers: z[n:]}, &hessian)
+
+		jacobian := make([][]float64, m)
+		for j, c := range p.Constraints {
+			jacobian[j] = Gradient(c.Function, z[:n])
+		}
+
+		if norm(residual) <= Tolerance {
+			if !optimal(p, hessian, jacobian) {
+				return nil, nil, ErrNotOptimal
+			}
+
+			return z[:n], z[n:], nil
+		}
+
+		system := matrix.Matrix{Rows: make([]matrix.Row, n+m)}
+		for i := range n {
+			system.Rows[i] = make(matrix.Row, n+m)
+			copy(system.Rows[i], hessian.Rows[i])
+		}
+
+		for j, gradient := range jacobian {
+			system.Rows[n+j] = make(matrix.Row, n+m)
+			for i, d := range gradient {
+				system.Rows[n+j][i] = d
+				system.Rows[i][n+j] = -d
+			}
+		}
+
+		d, ok := solveLinear(system, scaled(residual, -1))
+		if !ok {
+			if moved {
+				return nil, nil, ErrSingular
+			}
+
+			moved = true
+			q := p
+			q.Start = z[:n]
+			x, l, err := penalty(ctx, q, numbered)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			z = append(x, l...)
+			continue
+		}
+
+		next, ok := meritSearch(p, z, d, norm(residual))
+		if !ok {
+			return nil, nil, ErrNotConverged
+		}
+		z = next
+	}
+
+	return nil, nil, ErrNotConverged
+}
+
+// leastSquaresMultipliers solves J*J'*l = J*grad f, the multipliers the
+// gradient of the objective is the closest to J'*l with. They are zeros
+// when the gradients of the constraints are dependent.
+func leastSquaresMultipliers(p Problem, x []float64) []float64 {
+	m := len(p.Constraints)
+	jacobian := make([][]float64, m)
+	for j, c := range p.Constraints {
+		jacobian[j] = Gradient(c.Function, x)
+	}
+
+	g := Gradient(p.Objective, x)
+	system := matrix.Matrix{Rows: make([]matrix.Row, m)}
+	b := make([]float64, m)
+	for i := range m {
+		system.Rows[i] = make(matrix.Row, m)
+		for j := range m {
+			system.Rows[i][j] = dot(jacobian[i], jacobian[j])
+		}
+		b[i] = dot(jacobian[i], g)
+	}
+
+	l, ok := solveLinear(system, b)
+	if !ok {
+		return make([]float64, m)
+	}
+
+	return l
+}
+
+// optimal checks the second order condition: the Hessian of the
+// Lagrangian is semidefinite on the tangent space of the constraints,
+// positive for min and negative for max problems.
+func optimal(p Problem, hessian matrix.Matrix, jacobian [][]float64) bool {
+	sign := 1.
+	if p.Sense == lp.Maximize {
+		sign = -1
+	}
+
+	var scale float64
+	for _, row := range hessian.Rows {
+		for _, el := range row {
+			scale = max(scale, math.Abs(el))
+		}
+	}
+
+	basis := tangent(jacobian, len(hessian.Rows))
+	reduced := make([][]float64, len(basis))
+	for i, u := range basis {
+		reduced[i] = make([]float64, len(basis))
+		for j, v := range basis {
+			for k, row := range hessian.Rows {
+				reduced[i][j] += sign * u[k] * dot(row, v)
+			}
+		}
+	}
+
+	// the Cholesky decomposition of the reduced Hessian shifted by the
+	// error of the differences exists for the semidefinite one
+	l := make([][]float64, len(reduced))
+	for i := range reduced {
+		l[i] = make([]float64, len(reduced))
+		for j := 0; j <= i; j++ {
+			s := reduced[i][j]
+			if i == j {
+				s += curvatureTolerance * max(scale, 1)
+			}
+
+			for k := range j {
+				s -= l[i][k] * l[j][k]
+			}
+
+			if i == j {
+				if s <= 0 {
+					return false
+				}
+				l[i][i] = math.Sqrt(s)
+				continue
+			}
+
+			l[i][j] = s / l[j][j]
+		}
+	}
+
+	return true
+}
+
+// tangent is the orthonormal basis of the vectors orthogonal to every
+// gradient, it's built by the Gram-Schmidt process.
+func tangent(gradients [][]float64, n int) [][]float64 {
+	var normal, res [][]float64
+	add := func(v []float64, to *[][]float64) {
+		v = slices.Clone(v)
+		for _, u := range normal {
+			v = added(v, u, -dot(u, v))
+		}
+		for _, u := range res {
+			v = added(v, u, -dot(u, v))
+		}
+
+		if length := norm(v); length > dependentGradient {
+			*to = append(*to, scaled(v, 1/length))
+		}
+	}
+
+	for _, g := range gradients {
+		add(g, &normal)
+	}
+
+	for i := range n {
+		e := make([]float64, n)
+		e[i] = 1
+		add(e, &res)
+	}
+
+	return res
+}
+
+// meritSearch halves the Newton step until the norm of the Lagrange
+// system decreases.
+func meritSearch(p Problem, z, d []float64, merit float64) ([]float64, bool) {
+	step := 1.
+	for range maxHalvings {
+		next := added(z, d, step)
+		if norm(lagrangeResidual(p, next)) <= (1-armijo*step)*merit {
+			return next, true
+		}
+		step /= 2
+	}
+
+	return z, false
+}
+
+// lagrangian is f - sum(l_i * g_i) as the function of x.
+func lagrangian(p Problem, l []float64) Func {
+	return func(x []float64) float64 {
+		res := p.Objective(x)
+		for i, c := range p.Constraints {
+			res -= l[i] * c.Function(x)
+		}
+		return res
+	}
+}
+
+func lagrangeResidual(p Problem, z []float64) []float64 {
+	n := len(p.Variables)
+	res := Gradient(lagrangian(p, z[n:]), z[:n])
+	for _, c := range p.Constraints {
+		res = append(res, c.Function(z[:n]))
+	}
+
+	return res
+}
+
+// solveLinear is the Gaussian elimination with the partial pivoting, it
+// reports false for the singular matrix.
+func solveLinear(a matrix.Matrix, b []float64) ([]float64, bool) {
+	n := len(b)
+	rows := make([][]float64, n)
+	var scale float64
+	for i, row := range a.Rows {
+		rows[i] = append(append(make([]float64, 0, n+1), row...), b[i])
+		for _, el := range row {
+			scale = max(scale, math.Abs(el))
+		}
+	}
+
+	for col := range n {
+		pivot := col
+		for i := col + 1; i < n; i++ {
+			if math.Abs(rows[i][col]) > math.Abs(rows[pivot][col]) {
+				pivot = i
+			}
+		}
+
+		if math.Abs(rows[pivot][col]) <= singularPivot*max(scale, 1) {
+			return nil, false
+		}
+
+		rows[col], rows[pivot] = rows[pivot], rows[col]
+		for i := col + 1; i < n; i++ {
+			factor := rows[i][col] / rows[col][col]
+			for j := col; j <= n; j++ {
+				rows[i][j] -= factor * rows[col][j]
+			}
+		}
+	}
+
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		x[i] = rows[i][n]
+		for j := i + 1; j < n; j++ {
+			x[i] -= rows[i][j] * x[j]
+		}
+		x[i] /= rows[i][i]
+	}
+
+	return x, true
+}
+
+func added(x, d []float64, step float64) []float64 {
+	res := make([]float64, len(x))
+	for i := range x {
+		res[i] = x[i] + step*d[i]
+	}
+
+	return res
+}
+
+func scaled(v []float64, k float64) []float64 {
+	res := make([]float64, len(v))
+	for i, el := range v {
+		res[i] = k * el
+	}
+
+	return res
+}
+
+func dot(a, b []float64) float64 {
+	var res float64
+	for i := range a {
+		res += a[i] * b[i]
+	}
+
+	return res
+}
+
+func norm(v []float64) float64 {
+	return math.Sqrt(dot(v, v))
+}
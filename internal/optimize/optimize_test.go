@@ -0,0 +1,262 @@
+package optimize
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/lp"
+)
+
+func TestSolve(t *testing.T) {
+	tc := []struct {
+		name        string
+		problem     Problem
+		method      Method
+		optimal     []float64
+		objective   float64
+		multipliers []float64
+		err         error
+	}{
+		{
+			name: "Should descend to minimum of quadratic function",
+			problem: Problem{
+				Sense:     lp.Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: func(x []float64) float64 { return (x[0]-1)*(x[0]-1) + 2*(x[1]+2)*(x[1]+2) },
+			},
+			method:  GradientDescent,
+			optimal: []float64{1, -2},
+		},
+		{
+			name: "Should minimize Rosenbrock function with Newton's method",
+			problem: Problem{
+				Sense:     lp.Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: func(x []float64) float64 {
+					return 100*(x[1]-x[0]*x[0])*(x[1]-x[0]*x[0]) + (1-x[0])*(1-x[0])
+				},
+				Start: []float64{-1.2, 1},
+			},
+			method:  NewtonMethod,
+			optimal: []float64{1, 1},
+		},
+		{
+			name: "Should maximize concave function with Newton's method",
+			problem: Problem{
+				Sense:     lp.Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: func(x []float64) float64 { return -x[0]*x[0] - x[1]*x[1] + 2*x[0] },
+			},
+			method:    NewtonMethod,
+			optimal:   []float64{1, 0},
+			objective: 1,
+		},
+		{
+			name: "Should minimize distance to half-plane with penalty method",
+			problem: Problem{
+				Sense:     lp.Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: func(x []float64) float64 { return x[0]*x[0] + x[1]*x[1] },
+				Constraints: []Constraint{
+					{Function: func(x []float64) float64 { return x[0] + x[1] - 2 }, Relation: lp.GreaterEqual},
+				},
+			},
+			method:      PenaltyMethod,
+			optimal:     []float64{1, 1},
+			objective:   2,
+			multipliers: []float64{2},
+		},
+		{
+			name: "Should maximize linear function over disk with penalty method",
+			problem: Problem{
+				Sense:     lp.Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: func(x []float64) float64 { return x[0] + x[1] },
+				Constraints: []Constraint{
+					{Function: func(x []float64) float64 { return x[0]*x[0] + x[1]*x[1] - 2 }, Relation: lp.LessEqual},
+					{Function: func(x []float64) float64 { return x[0] - 5 }, Relation: lp.LessEqual},
+				},
+			},
+			method:      PenaltyMethod,
+			optimal:     []float64{1, 1},
+			objective:   2,
+			multipliers: []float64{0.5, 0},
+		},
+		{
+			name: "Should minimize distance to line with Lagrange method",
+			problem: Problem{
+				Sense:     lp.Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: func(x []float64) float64 { return x[0]*x[0] + x[1]*x[1] },
+				Constraints: []Constraint{
+					{Function: func(x []float64) float64 { return x[0] + x[1] - 2 }, Relation: lp.Equal},
+				},
+			},
+			method:      LagrangeMethod,
+			optimal:     []float64{1, 1},
+			objective:   2,
+			multipliers: []float64{2},
+		},
+		{
+			name: "Should maximize product with fixed sum with Lagrange method",
+			problem: Problem{
+				Sense:     lp.Maximize,
+				Variables: []string{"x1", "x2"},
+				Objective: func(x []float64) float64 { return x[0] * x[1] },
+				Constraints: []Constraint{
+					{Function: func(x []float64) float64 { return x[0] + x[1] - 4 }, Relation: lp.Equal},
+				},
+			},
+			method:      LagrangeMethod,
+			optimal:     []float64{2, 2},
+			objective:   4,
+			multipliers: []float64{2},
+		},
+		{
+			name: "Should reject constraints for gradient descent",
+			problem: Problem{
+				Sense:     lp.Minimize,
+				Variables: []string{"x1"},
+				Objective: func(x []float64) float64 { return x[0] * x[0] },
+				Constraints: []Constraint{
+					{Function: func(x []float64) float64 { return x[0] - 1 }, Relation: lp.Equal},
+				},
+			},
+			method: GradientDescent,
+			err:    ErrConstrained,
+		},
+		{
+			name: "Should reject inequalities for Lagrange method",
+			problem: Problem{
+				Sense:     lp.Minimize,
+				Variables: []string{"x1"},
+				Objective: func(x []float64) float64 { return x[0] * x[0] },
+				Constraints: []Constraint{
+					{Function: func(x []float64) float64 { return x[0] - 1 }, Relation: lp.GreaterEqual},
+				},
+			},
+			method: LagrangeMethod,
+			err:    ErrInequality,
+		},
+		{
+			name: "Should not converge for unbounded function",
+			problem: Problem{
+				Sense:     lp.Minimize,
+				Variables: []string{"x1"},
+				Objective: func(x []float64) float64 { return x[0] },
+			},
+			method: GradientDescent,
+			err:    ErrNotConverged,
+		},
+		{
+			name: "Should reject start point of wrong size",
+			problem: Problem{
+				Sense:     lp.Minimize,
+				Variables: []string{"x1"},
+				Objective: func(x []float64) float64 { return x[0] * x[0] },
+				Start:     []float64{1, 2},
+			},
+			method: NewtonMethod,
+			err:    ErrInvalidStart,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Solve(context.Background(), tt.problem, tt.method)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			for i := range tt.optimal {
+				if math.Abs(got.Optimal[i]-tt.optimal[i]) > lp.Tolerance {
+					t.Fatalf("Expected to get: %v, got: %v", tt.optimal, got.Optimal)
+				}
+			}
+
+			if math.Abs(got.Objective-tt.objective) > lp.Tolerance {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, got.Objective)
+			}
+
+			for i := range tt.multipliers {
+				if math.Abs(got.Multipliers[i]-tt.multipliers[i]) > lp.Tolerance {
+					t.Fatalf("Expected to get: %v, got: %v", tt.multipliers, got.Multipliers)
+				}
+			}
+
+			last := got.Trajectory[len(got.Trajectory)-1]
+			for i := range got.Optimal {
+				if last.X[i] != got.Optimal[i] {
+					t.Fatalf("Expected to get: %v, got: %v", got.Optimal, last.X)
+				}
+			}
+		})
+	}
+}
+
+func TestLagrangeStartPoints(t *testing.T) {
+	tc := []struct {
+		name  string
+		start []float64
+		err   error
+	}{
+		{
+			name: "Should leave degenerate origin",
+		},
+		{
+			name:  "Should converge from start point with linear objective",
+			start: []float64{0.5, -1},
+		},
+		{
+			name:  "Should stay at optimum",
+			start: []float64{-1, -1},
+		},
+		{
+			name:  "Should reject constrained maximum for min problem",
+			start: []float64{1, 1},
+			err:   ErrNotOptimal,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			problem := Problem{
+				Sense:     lp.Minimize,
+				Variables: []string{"x1", "x2"},
+				Objective: func(x []float64) float64 { return x[0] + x[1] },
+				Constraints: []Constraint{
+					{Function: func(x []float64) float64 { return x[0]*x[0] + x[1]*x[1] - 2 }, Relation: lp.Equal},
+				},
+				Start: tt.start,
+			}
+
+			got, err := Solve(context.Background(), problem, LagrangeMethod)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			expected := []float64{-1, -1}
+			for i := range expected {
+				if math.Abs(got.Optimal[i]-expected[i]) > lp.Tolerance {
+					t.Fatalf("Expected to get: %v, got: %v", expected, got.Optimal)
+				}
+			}
+
+			if math.Abs(got.Multipliers[0]+0.5) > lp.Tolerance {
+				t.Fatalf("Expected to get: %v, got: %v", -0.5, got.Multipliers)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package optimize
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/cli/parse"
+	"github.com/hrvadl/algo/internal/lp"
+)
+
+var (
+	ErrNoObjective  = errors.New("problem should have the objective")
+	ErrInvalidStart = errors.New("start point should have a value per variable")
+)
+
+// Func is a function of the problem variables, the expressions read by
+// the parse package are turned into it with FromExpression.
+type Func func(x []float64) float64
+
+// Constraint keeps Function(x) Relation 0, the parsed "g(x) <= h(x)"
+// becomes g(x) - h(x) <= 0.
+type Constraint struct {
+	Name     string
+	Function Func
+	Relation lp.Relation
+}
+
+// Problem is a non-linear program, Start is the point the methods begin
+// from, the origin is taken when it's empty.
+type Problem struct {
+	Sense       lp.Sense
+	Variables   []string
+	Objective   Func
+	Constraints []Constraint
+	Start       []float64
+}
+
+func FromExpression(f parse.Function) Func {
+	return f.Eval
+}
+
+// Parse builds the problem from the expressions parse.FunctionFromString
+// accepts, variables are named x1, x2 and so on.
+func Parse(goal, objective string, constraints []string) (Problem, error) {
+	p := Problem{
+		Sense:       lp.Sense(goal),
+		Constraints: make([]Constraint, 0, len(constraints)),
+	}
+
+	if p.Sense != lp.Maximize && p.Sense != lp.Minimize {
+		return Problem{}, lp.ErrInvalidSense
+	}
+
+	f, err := parse.FunctionFromString(objective)
+	if err != nil {
+		return Problem{}, err
+	}
+
+	n := f.Variables
+	p.Objective = FromExpression(f)
+	for _, str := range constraints {
+		c, variables, err := parseConstraint(str)
+		if err != nil {
+			return Problem{}, fmt.Errorf("constraint %q: %w", str, err)
+		}
+
+		n = max(n, variables)
+		p.Constraints = append(p.Constraints, c)
+	}
+
+	p.Variables = make([]string, n)
+	for i := range p.Variables {
+		p.Variables[i] = fmt.Sprintf("x%d", i+1)
+	}
+
+	return p, p.Validate()
+}
+
+func parseConstraint(str string) (Constraint, int, error) {
+	var (
+		relation lp.Relation
+		left     string
+		right    string
+		found    bool
+	)
+
+	for _, r := range []lp.Relation{lp.LessEqual, lp.GreaterEqual, "<", ">", lp.Equal} {
+		if left, right, found = strings.Cut(str, string(r)); found {
+			relation = r
+			break
+		}
+	}
+
+	switch relation {
+	case "<":
+		relation = lp.LessEqual
+	case ">":
+		relation = lp.GreaterEqual
+	case "":
+		return Constraint{}, 0, lp.ErrInvalidRelation
+	}
+
+	l, err := parse.FunctionFromString(left)
+	if err != nil {
+		return Constraint{}, 0, err
+	}
+
+	r, err := parse.FunctionFromString(right)
+	if err != nil {
+		return Constraint{}, 0, err
+	}
+
+	c := Constraint{
+		Function: func(x []float64) float64 { return l.Eval(x) - r.Eval(x) },
+		Relation: relation,
+	}
+
+	return c, max(l.Variables, r.Variables), nil
+}
+
+func (p Problem) Validate() error {
+	if p.Sense != lp.Maximize && p.Sense != lp.Minimize {
+		return lp.ErrInvalidSense
+	}
+
+	if len(p.Variables) == 0 {
+		return lp.ErrNoVariables
+	}
+
+	if p.Objective == nil {
+		return ErrNoObjective
+	}
+
+	if len(p.Start) != 0 && len(p.Start) != len(p.Variables) {
+		return ErrInvalidStart
+	}
+
+	for i, c := range p.Constraints {
+		if c.Function == nil {
+			return fmt.Errorf("constraint %s should have the function", p.ConstraintName(i))
+		}
+
+		switch c.Relation {
+		case lp.LessEqual, lp.GreaterEqual, lp.Equal:
+		default:
+			return fmt.Errorf("constraint %s: %w", p.ConstraintName(i), lp.ErrInvalidRelation)
+		}
+	}
+
+	return nil
+}
+
+// ConstraintName returns the name of the i-th constraint, unnamed ones
+// are called g1, g2 and so on.
+func (p Problem) ConstraintName(i int) string {
+	if name := p.Constraints[i].Name; name != "" {
+		return name
+	}
+
+	return fmt.Sprintf("g%d", i+1)
+}
+
+func (p Problem) start() []float64 {
+	x := make([]float64, len(p.Variables))
+	copy(x, p.Start)
+	return x
+}
+
+// minimized is the objective min problems have, max ones are negated.
+func (p Problem) minimized() Func {
+	if p.Sense == lp.Minimize {
+		return p.Objective
+	}
+
+	return func(x []float64) float64 { return -p.Objective(x) }
+}
@@ -0,0 +1,81 @@
+package optimize
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/lp"
+)
+
+func TestParse(t *testing.T) {
+	tc := []struct {
+		name        string
+		goal        string
+		objective   string
+		constraints []string
+		x           []float64
+		variables   []string
+		values      []float64
+		relations   []lp.Relation
+		err         error
+	}{
+		{
+			name:        "Should move right hand sides to the left",
+			goal:        "min",
+			objective:   "x1^2+x2^2",
+			constraints: []string{"x1 + x2 >= 2", "x1*x3 <= x2^2", "exp(x1) = 1"},
+			x:           []float64{1, 2, 3},
+			variables:   []string{"x1", "x2", "x3"},
+			values:      []float64{1, -1, math.E - 1},
+			relations:   []lp.Relation{lp.GreaterEqual, lp.LessEqual, lp.Equal},
+		},
+		{
+			name:        "Should read strict relations as non-strict",
+			goal:        "max",
+			objective:   "x1",
+			constraints: []string{"x1 < 1", "x1 > 0"},
+			x:           []float64{2},
+			variables:   []string{"x1"},
+			values:      []float64{1, 2},
+			relations:   []lp.Relation{lp.LessEqual, lp.GreaterEqual},
+		},
+		{
+			name:      "Should reject unknown goal",
+			goal:      "maximize",
+			objective: "x1",
+			err:       lp.ErrInvalidSense,
+		},
+		{
+			name:        "Should reject constraint without relation",
+			goal:        "min",
+			objective:   "x1",
+			constraints: []string{"x1+1"},
+			err:         lp.ErrInvalidRelation,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Parse(tt.goal, tt.objective, tt.constraints)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			if len(got.Variables) != len(tt.variables) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.variables, got.Variables)
+			}
+
+			for i, c := range got.Constraints {
+				if v := c.Function(tt.x); math.Abs(v-tt.values[i]) > 1e-9 || c.Relation != tt.relations[i] {
+					t.Fatalf("Expected to get: %v %v, got: %v %v", tt.values[i], tt.relations[i], v, c.Relation)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package qp
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+const LemkeStage matrix.Stage = "lemke"
+
+const (
+	// eps keeps the rounding errors from being taken for positive
+	// elements in the ratio test.
+	eps = 1e-9
+
+	// maxLemkeIterations stops the degenerate pivots from cycling forever.
+	maxLemkeIterations = 10000
+)
+
+var (
+	ErrLCPSize = errors.New("lcp matrix should be square and have a row per free term")
+	ErrRay     = errors.New("complementary pivoting ended on a ray: problem is infeasible or unbounded")
+	ErrCycling = errors.New("complementary pivoting is cycling")
+)
+
+var (
+	// artificial is z0 of the Lemke's method, it's the only variable
+	// without a complementary one.
+	artificial  = matrix.Variable{FirstStageName: "a"}
+	complements = map[string]string{"w": "z", "z": "w"}
+)
+
+// LCPSolution holds w = q + M*z with w, z >= 0 and w*z = 0 together with
+// the last tableau.
+type LCPSolution struct {
+	W      []float64
+	Z      []float64
+	Matrix matrix.Matrix
+}
+
+// SolveLCP is the Lemke's complementary pivoting with the covering
+// vector of ones. The tableau keeps the w variables on the left and z
+// on the top, the same way the simplex keeps y and x: w = q - (-M)*z.
+func SolveLCP(ctx context.Context, m matrix.Matrix, q []float64) (*LCPSolution, error) {
+	n := len(q)
+	if len(m.Rows) != n {
+		return nil, ErrLCPSize
+	}
+
+	t := matrix.Matrix{
+		Rows:      make([]matrix.Row, n),
+		LeftTitle: make([]matrix.Variable, n),
+		TopTitle:  make([]matrix.Variable, n+2),
+	}
+
+	start := 0
+	for i, row := range m.Rows {
+		if len(row) != n {
+			return nil, ErrLCPSize
+		}
+
+		t.Rows[i] = make(matrix.Row, n+2)
+		for j, el := range row {
+			t.Rows[i][j] = -el
+		}
+		t.Rows[i][n], t.Rows[i][n+1] = -1, q[i]
+		t.LeftTitle[i] = matrix.Variable{FirstStageName: "w", FirstStageIndex: i}
+		t.TopTitle[i] = matrix.Variable{FirstStageName: "z", FirstStageIndex: i}
+
+		if q[i] < q[start] {
+			start = i
+		}
+	}
+	t.TopTitle[n] = artificial
+	t.TopTitle[n+1] = matrix.Variable{FirstStageName: "1"}
+
+	if n == 0 || q[start] >= 0 {
+		return lcpSolution(t), nil
+	}
+
+	col, row := n, start
+	for range maxLemkeIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		before := matrix.PivotSnapshot(ctx, t)
+		next, err := t.JordanEliminateModified(col, row)
+		if err != nil {
+			return nil, err
+		}
+
+		t = next
+		matrix.NotifyPivot(ctx, LemkeStage, row, col, before, t)
+		matrix.PrintStep(ctx, t, "\nElement col: %v row: %v, Matrix:\n\n", col, row)
+
+		left := t.TopTitle[col]
+		if left == artificial {
+			return lcpSolution(t), nil
+		}
+
+		entering := matrix.Variable{
+			FirstStageName:  complements[left.FirstStageName],
+			FirstStageIndex: left.FirstStageIndex,
+		}
+
+		col = -1
+		for j, v := range t.TopTitle {
+			if v == entering {
+				col = j
+				break
+			}
+		}
+
+		if row = ratio(t, col); row == -1 {
+			return nil, ErrRay
+		}
+	}
+
+	return nil, ErrCycling
+}
+
+// ratio is the minimum ratio test, the artificial variable leaves first
+// on a tie so the pivoting ends as soon as it can.
+func ratio(t matrix.Matrix, col int) int {
+	lastCol := len(t.Rows[0]) - 1
+	row, best := -1, math.Inf(1)
+	for i, r := range t.Rows {
+		if r[col] <= eps {
+			continue
+		}
+
+		v := r[lastCol] / r[col]
+		switch {
+		case v < best-eps:
+			row, best = i, v
+		case v <= best+eps && t.LeftTitle[i] == artificial:
+			row = i
+		}
+	}
+
+	return row
+}
+
+func lcpSolution(t matrix.Matrix) *LCPSolution {
+	n := len(t.Rows)
+	lastCol := len(t.Rows[0]) - 1
+	res := &LCPSolution{W: make([]float64, n), Z: make([]float64, n), Matrix: t}
+	for i, v := range t.LeftTitle {
+		value := max(t.Rows[i][lastCol], 0)
+		switch v.FirstStageName {
+		case "w":
+			res.W[v.FirstStageIndex] = value
+		case "z":
+			res.Z[v.FirstStageIndex] = value
+		}
+	}
+
+	return res
+}
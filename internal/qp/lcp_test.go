@@ -0,0 +1,80 @@
+package qp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestSolveLCP(t *testing.T) {
+	tc := []struct {
+		name string
+		m    matrix.Matrix
+		q    []float64
+		z    []float64
+		err  error
+	}{
+		{
+			name: "Should return zero for non-negative free terms",
+			m:    matrix.Matrix{Rows: []matrix.Row{{2, 1}, {1, 2}}},
+			q:    []float64{1, 0},
+			z:    []float64{0, 0},
+		},
+		{
+			name: "Should solve positive definite problem",
+			m:    matrix.Matrix{Rows: []matrix.Row{{2, 1}, {1, 2}}},
+			q:    []float64{-5, -6},
+			z:    []float64{4. / 3, 7. / 3},
+		},
+		{
+			name: "Should keep one variable at zero",
+			m:    matrix.Matrix{Rows: []matrix.Row{{2, 1}, {1, 2}}},
+			q:    []float64{-1, 2},
+			z:    []float64{0.5, 0},
+		},
+		{
+			name: "Should end on ray",
+			m:    matrix.Matrix{Rows: []matrix.Row{{0, -1}, {1, 0}}},
+			q:    []float64{-1, -1},
+			err:  ErrRay,
+		},
+		{
+			name: "Should reject matrix of wrong size",
+			m:    matrix.Matrix{Rows: []matrix.Row{{1, 0}}},
+			q:    []float64{-1, -1},
+			err:  ErrLCPSize,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SolveLCP(context.Background(), tt.m, tt.q)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			for i, row := range tt.m.Rows {
+				w := tt.q[i]
+				for j, el := range row {
+					w += el * got.Z[j]
+				}
+
+				if math.Abs(w-got.W[i]) > 1e-6 || got.W[i]*got.Z[i] > 1e-6 {
+					t.Fatalf("Expected to get complementary solution, got: w = %v, z = %v", got.W, got.Z)
+				}
+
+				if math.Abs(got.Z[i]-tt.z[i]) > 1e-6 {
+					t.Fatalf("Expected to get: %v, got: %v", tt.z, got.Z)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package qp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+var ErrNotConvex = errors.New("quadratic part should be convex for min and concave for max")
+
+// Problem is 1/2*x'Qx + c'x over the linear constraints and bounds of the
+// embedded linear problem, its Objective holds c.
+type Problem struct {
+	lp.Problem
+	Q matrix.Matrix
+}
+
+// Solution keeps the last tableau of the complementary pivoting, Dual
+// holds the shadow prices of the constraints in the lp sense.
+type Solution struct {
+	Variables []string
+	Optimal   []float64
+	Objective float64
+	Dual      []float64
+	Matrix    matrix.Matrix
+}
+
+// Parse reads the linear part and the constraints the way lp.Parse does,
+// Q may bring variables the expressions don't mention.
+func Parse(goal string, q matrix.Matrix, linear string, constraints []string) (Problem, error) {
+	p, err := lp.Parse(goal, linear, constraints)
+	if err != nil {
+		return Problem{}, err
+	}
+
+	for n := len(p.Variables); n < len(q.Rows); n++ {
+		p.Variables = append(p.Variables, fmt.Sprintf("x%d", n+1))
+		p.Objective = append(p.Objective, 0)
+		for i := range p.Constraints {
+			p.Constraints[i].Coefficients = append(p.Constraints[i].Coefficients, 0)
+		}
+	}
+
+	res := Problem{Problem: p, Q: q}
+	return res, res.Validate()
+}
+
+func (p Problem) Validate() error {
+	if err := p.Problem.Validate(); err != nil {
+		return err
+	}
+
+	n := len(p.Variables)
+	if len(p.Q.Rows) != n {
+		return lp.ErrQuadraticSize
+	}
+
+	for _, row := range p.Q.Rows {
+		if len(row) != n {
+			return lp.ErrQuadraticSize
+		}
+	}
+
+	sign := 1.
+	if p.Sense == lp.Maximize {
+		sign = -1
+	}
+
+	if !semidefinite(p.Q, sign) {
+		return ErrNotConvex
+	}
+
+	return nil
+}
+
+// Value returns 1/2*x'Qx + c'x.
+func (p Problem) Value(x []float64) float64 {
+	var res float64
+	for i, row := range p.Q.Rows {
+		for j, el := range row {
+			res += x[i] * el * x[j] / 2
+		}
+		res += p.Problem.Objective[i] * x[i]
+	}
+
+	return res
+}
+
+// Solve writes the optimality conditions of the problem as the linear
+// complementarity problem and solves it with the Lemke's method. For
+// the columns y and the rows A*y <= b of the lp tableau they are
+//
+//	v = R*y + l + A'*u, s = b - A*y, v*y = 0, s*u = 0,
+//
+// with every variable non-negative. Max problems are solved as min of
+// the negated objective, equations are split into two rows.
+func Solve(ctx context.Context, p Problem) (*Solution, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	t, err := p.Compile()
+	if err != nil {
+		return nil, err
+	}
+
+	r, linear, _, err := t.Quadratic(p.Q)
+	if err != nil {
+		return nil, err
+	}
+
+	sign := 1.
+	if p.Sense == lp.Maximize {
+		sign = -1
+	}
+
+	last := len(t.Matrix.Rows) - 1
+	cols := len(t.Matrix.Rows[0]) - 1
+	for j := range linear {
+		linear[j] = sign * (linear[j] - t.Matrix.Rows[last][j])
+		for k := range r.Rows[j] {
+			r.Rows[j][k] *= sign
+		}
+	}
+
+	var a []matrix.Row
+	var constraint []int
+	var rowSign []float64
+	for i, row := range t.Matrix.Rows[:last] {
+		a = append(a, row)
+		constraint = append(constraint, i)
+		rowSign = append(rowSign, 1)
+		if t.Matrix.LeftTitle[i].IsZero() {
+			negated := slices.Clone(row)
+			for j := range negated {
+				negated[j] *= -1
+			}
+			a = append(a, negated)
+			constraint = append(constraint, i)
+			rowSign = append(rowSign, -1)
+		}
+	}
+
+	n := cols + len(a)
+	m := matrix.Matrix{Rows: make([]matrix.Row, n)}
+	q := make([]float64, n)
+	for j := range cols {
+		m.Rows[j] = make(matrix.Row, n)
+		copy(m.Rows[j], r.Rows[j])
+		for i, row := range a {
+			m.Rows[j][cols+i] = row[j]
+		}
+		q[j] = linear[j]
+	}
+
+	for i, row := range a {
+		m.Rows[cols+i] = make(matrix.Row, n)
+		for j := range cols {
+			m.Rows[cols+i][j] = -row[j]
+		}
+		q[cols+i] = row[cols]
+	}
+
+	lcp, err := SolveLCP(ctx, m, q)
+	if err != nil {
+		return nil, err
+	}
+
+	x := t.Decode(lcp.Z[:cols])
+	res := &Solution{
+		Variables: p.Variables,
+		Optimal:   x,
+		Objective: p.Value(x),
+		Dual:      make([]float64, len(p.Constraints)),
+		Matrix:    lcp.Matrix,
+	}
+
+	// a row with the right hand side b loosened by one lowers the min
+	// by its multiplier, >= rows are negated by the compilation.
+	for k, i := range constraint {
+		if i >= len(p.Constraints) {
+			continue
+		}
+
+		price := -rowSign[k] * lcp.Z[cols+k]
+		if p.Constraints[i].Relation == lp.GreaterEqual {
+			price *= -1
+		}
+		res.Dual[i] += sign * price
+	}
+
+	return res, nil
+}
+
+// semidefinite checks that sign*Q is positive semidefinite: the Cholesky
+// decomposition of its symmetric part shifted by a tiny diagonal exists.
+func semidefinite(q matrix.Matrix, sign float64) bool {
+	n := len(q.Rows)
+	var scale float64
+	for _, row := range q.Rows {
+		for _, el := range row {
+			scale = max(scale, math.Abs(el))
+		}
+	}
+
+	l := make([][]float64, n)
+	for i := range n {
+		l[i] = make([]float64, n)
+		for j := 0; j <= i; j++ {
+			s := sign * (q.Rows[i][j] + q.Rows[j][i]) / 2
+			if i == j {
+				s += eps * max(scale, 1)
+			}
+
+			for k := range j {
+				s -= l[i][k] * l[j][k]
+			}
+
+			if i == j {
+				if s <= 0 {
+					return false
+				}
+				l[i][i] = math.Sqrt(s)
+				continue
+			}
+
+			l[i][j] = s / l[j][j]
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,207 @@
+package qp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestSolve(t *testing.T) {
+	tc := []struct {
+		name      string
+		problem   Problem
+		optimal   []float64
+		objective float64
+		dual      []float64
+		err       error
+	}{
+		{
+			name: "Should project unconstrained minimum onto constraint",
+			problem: Problem{
+				Problem: lp.Problem{
+					Sense:     lp.Minimize,
+					Variables: []string{"x1", "x2"},
+					Objective: []float64{-2, -4},
+					Constraints: []lp.Constraint{
+						{Coefficients: []float64{1, 1}, Relation: lp.LessEqual, RHS: 2},
+					},
+				},
+				Q: matrix.Matrix{Rows: []matrix.Row{{2, 0}, {0, 2}}},
+			},
+			optimal:   []float64{0.5, 1.5},
+			objective: -4.5,
+			dual:      []float64{-1},
+		},
+		{
+			name: "Should maximize concave objective",
+			problem: Problem{
+				Problem: lp.Problem{
+					Sense:     lp.Maximize,
+					Variables: []string{"x1", "x2"},
+					Objective: []float64{4, 6},
+					Constraints: []lp.Constraint{
+						{Coefficients: []float64{1, 1}, Relation: lp.LessEqual, RHS: 3},
+					},
+				},
+				Q: matrix.Matrix{Rows: []matrix.Row{{-2, 0}, {0, -2}}},
+			},
+			optimal:   []float64{1, 2},
+			objective: 11,
+			dual:      []float64{2},
+		},
+		{
+			name: "Should minimize portfolio variance",
+			problem: Problem{
+				Problem: lp.Problem{
+					Sense:     lp.Minimize,
+					Variables: []string{"x1", "x2"},
+					Objective: []float64{0, 0},
+					Constraints: []lp.Constraint{
+						{Coefficients: []float64{1, 1}, Relation: lp.Equal, RHS: 1},
+					},
+				},
+				Q: matrix.Matrix{Rows: []matrix.Row{{2, 0}, {0, 4}}},
+			},
+			optimal:   []float64{2. / 3, 1. / 3},
+			objective: 2. / 3,
+			dual:      []float64{4. / 3},
+		},
+		{
+			name: "Should stop at lower bound of shifted variable",
+			problem: Problem{
+				Problem: lp.Problem{
+					Sense:     lp.Minimize,
+					Variables: []string{"x1", "x2"},
+					Objective: []float64{6, -2},
+					Constraints: []lp.Constraint{
+						{Coefficients: []float64{1, 1}, Relation: lp.GreaterEqual, RHS: -10},
+					},
+					Bounds: []lp.Bound{
+						{Lower: math.Inf(-1), Upper: math.Inf(1)},
+						{Lower: 2, Upper: 5},
+					},
+				},
+				Q: matrix.Matrix{Rows: []matrix.Row{{2, 0}, {0, 2}}},
+			},
+			optimal:   []float64{-3, 2},
+			objective: -9,
+			dual:      []float64{0},
+		},
+		{
+			name: "Should take symmetric part of asymmetric matrix",
+			problem: Problem{
+				Problem: lp.Problem{
+					Sense:     lp.Minimize,
+					Variables: []string{"x1", "x2"},
+					Objective: []float64{-4, -4},
+					Constraints: []lp.Constraint{
+						{Coefficients: []float64{1, 1}, Relation: lp.LessEqual, RHS: 10},
+					},
+				},
+				Q: matrix.Matrix{Rows: []matrix.Row{{2, 2}, {0, 2}}},
+			},
+			optimal:   []float64{4. / 3, 4. / 3},
+			objective: -16. / 3,
+			dual:      []float64{0},
+		},
+		{
+			name: "Should reject non-convex objective",
+			problem: Problem{
+				Problem: lp.Problem{
+					Sense:     lp.Minimize,
+					Variables: []string{"x1"},
+					Objective: []float64{0},
+					Constraints: []lp.Constraint{
+						{Coefficients: []float64{1}, Relation: lp.LessEqual, RHS: 1},
+					},
+				},
+				Q: matrix.Matrix{Rows: []matrix.Row{{-1}}},
+			},
+			err: ErrNotConvex,
+		},
+		{
+			name: "Should reject infeasible problem",
+			problem: Problem{
+				Problem: lp.Problem{
+					Sense:     lp.Minimize,
+					Variables: []string{"x1"},
+					Objective: []float64{0},
+					Constraints: []lp.Constraint{
+						{Coefficients: []float64{1}, Relation: lp.GreaterEqual, RHS: 2},
+						{Coefficients: []float64{1}, Relation: lp.LessEqual, RHS: 1},
+					},
+				},
+				Q: matrix.Matrix{Rows: []matrix.Row{{1}}},
+			},
+			err: ErrRay,
+		},
+		{
+			name: "Should reject unbounded problem",
+			problem: Problem{
+				Problem: lp.Problem{
+					Sense:     lp.Minimize,
+					Variables: []string{"x1", "x2"},
+					Objective: []float64{-1, 0},
+					Constraints: []lp.Constraint{
+						{Coefficients: []float64{0, 1}, Relation: lp.LessEqual, RHS: 1},
+					},
+				},
+				Q: matrix.Matrix{Rows: []matrix.Row{{0, 0}, {0, 1}}},
+			},
+			err: ErrRay,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Solve(context.Background(), tt.problem)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			if math.Abs(got.Objective-tt.objective) > lp.Tolerance {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, got.Objective)
+			}
+
+			for i := range tt.optimal {
+				if math.Abs(got.Optimal[i]-tt.optimal[i]) > lp.Tolerance {
+					t.Fatalf("Expected to get: %v, got: %v", tt.optimal, got.Optimal)
+				}
+			}
+
+			for i := range tt.dual {
+				if math.Abs(got.Dual[i]-tt.dual[i]) > lp.Tolerance {
+					t.Fatalf("Expected to get: %v, got: %v", tt.dual, got.Dual)
+				}
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	q := matrix.Matrix{Rows: []matrix.Row{{2, 0, 0}, {0, 2, 0}, {0, 0, 2}}}
+	got, err := Parse("min", q, "-2x1-4x2", []string{"x1+x2<=2"})
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	expected := []string{"x1", "x2", "x3"}
+	for i, v := range expected {
+		if got.Variables[i] != v || len(got.Constraints[0].Coefficients) != len(expected) {
+			t.Fatalf("Expected to get: %v, got: %v", expected, got.Variables)
+		}
+	}
+
+	if _, err := Parse("max", q, "x1", []string{"x1<=2"}); !errors.Is(err, ErrNotConvex) {
+		t.Fatalf("Expected to get error: %v, got: %v", ErrNotConvex, err)
+	}
+}
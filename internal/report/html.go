@@ -0,0 +1,69 @@
+package report
+
+import (
+	"html/template"
+	"io"
+)
+
+var page = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em auto; max-width: 60em; }
+table { border-collapse: collapse; margin: 1em 0; }
+th, td { border: 1px solid #ccc; padding: 0.25em 0.75em; text-align: right; }
+th:first-child { text-align: left; }
+tr.z { border-top: 2px solid #333; font-weight: bold; }
+td.pivot { background: #fff3a0; font-weight: bold; }
+code { background: #f4f4f4; padding: 0 0.25em; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<h2>Problem</h2>
+<p>{{.Goal}} <code>z = {{.Objective}}</code></p>
+{{- if .Constraints}}
+<p>Subject to:</p>
+<ul>
+{{- range .Constraints}}
+<li><code>{{.}}</code></li>
+{{- end}}
+</ul>
+{{- end}}
+<p>Initial tableau:</p>
+{{template "table" .Problem}}
+{{- range .Steps}}
+{{- if .NewStage}}
+<h2>{{.Stage}}</h2>
+{{- end}}
+<h3>Step {{.Index}}</h3>
+<p>Pivot element <strong>{{.Pivot}}</strong> in row <code>{{.Row}}</code>, column <code>{{.Col}}</code>.</p>
+{{template "table" .Table}}
+{{- end}}
+<h2>Result</h2>
+<ul>
+<li>Support solution: {{.Support}}</li>
+<li>Optimal solution: {{.Optimal}}</li>
+<li>Dual solution: {{.Dual}}</li>
+<li>{{.Goal}} z = {{.Value}}</li>
+</ul>
+</body>
+</html>
+{{define "table"}}
+{{- if .Rows}}
+<table>
+<tr><th></th>{{range .Header}}<th>{{.}}</th>{{end}}</tr>
+{{- range .Rows}}
+<tr{{if .Z}} class="z"{{end}}><th>{{.Title}}</th>{{range .Cells}}<td{{if .Pivot}} class="pivot"{{end}}>{{.Value}}</td>{{end}}</tr>
+{{- end}}
+</table>
+{{- end}}
+{{- end}}
+`))
+
+// HTML renders the report as a standalone page without external assets.
+func (r *Report) HTML(w io.Writer) error {
+	return page.Execute(w, r.view())
+}
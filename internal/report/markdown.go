@@ -0,0 +1,104 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Markdown renders the report as GitHub flavored markdown.
+func (r *Report) Markdown(w io.Writer) error {
+	v := r.view()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", markdownEscape(v.Title))
+	b.WriteString("## Problem\n\n")
+	fmt.Fprintf(&b, "%s `z = %s`\n\n", v.Goal, v.Objective)
+	if len(v.Constraints) > 0 {
+		b.WriteString("Subject to:\n\n")
+		for _, c := range v.Constraints {
+			fmt.Fprintf(&b, "- `%s`\n", c)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("Initial tableau:\n\n")
+	b.WriteString(markdownTable(v.Problem))
+
+	var stage string
+	for _, s := range v.Steps {
+		if s.NewStage {
+			stage = s.Stage
+			fmt.Fprintf(&b, "\n## %s\n", markdownEscape(stage))
+		}
+
+		fmt.Fprintf(
+			&b,
+			"\n### Step %d\n\nPivot element **%s** in row `%s`, column `%s`.\n\n",
+			s.Index,
+			s.Pivot,
+			s.Row,
+			s.Col,
+		)
+		b.WriteString(markdownTable(s.Table))
+	}
+
+	b.WriteString("\n## Result\n\n")
+	fmt.Fprintf(&b, "- Support solution: %s\n", v.Support)
+	fmt.Fprintf(&b, "- Optimal solution: %s\n", v.Optimal)
+	fmt.Fprintf(&b, "- Dual solution: %s\n", v.Dual)
+	fmt.Fprintf(&b, "- %s z = %s\n", v.Goal, v.Value)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func markdownTable(t table) string {
+	if len(t.Rows) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("|")
+	for _, h := range append([]string{""}, t.Header...) {
+		fmt.Fprintf(&b, " %s |", h)
+	}
+
+	b.WriteString("\n|---|")
+	b.WriteString(strings.Repeat("--:|", len(t.Header)))
+	b.WriteString("\n")
+
+	for _, r := range t.Rows {
+		title := r.Title
+		if r.Z {
+			title = "**" + title + "**"
+		}
+
+		fmt.Fprintf(&b, "| %s |", title)
+		for _, c := range r.Cells {
+			if c.Pivot {
+				fmt.Fprintf(&b, " **[%s]** |", c.Value)
+				continue
+			}
+
+			fmt.Fprintf(&b, " %s |", c.Value)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`#`, `\#`,
+	`|`, `\|`,
+	`<`, `&lt;`,
+)
+
+func markdownEscape(s string) string {
+	return markdownEscaper.Replace(s)
+}
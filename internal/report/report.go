@@ -0,0 +1,226 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/latex"
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/matrix"
+	"github.com/hrvadl/algo/internal/workspace"
+)
+
+const (
+	DefaultPrecision = 2
+	DefaultTitle     = "Linear programming solution"
+)
+
+// Report is a solved lp model together with the pivot steps the solver
+// made. It is rendered with Markdown or HTML.
+type Report struct {
+	Title     string
+	Precision int
+	Model     workspace.Model
+	Problem   matrix.Matrix
+	Steps     []matrix.PivotStep
+	Solution  lp.Solution
+}
+
+// Build solves the model with the doubled method and records every
+// pivot of the solver.
+func Build(ctx context.Context, model workspace.Model) (*Report, error) {
+	problem, err := model.Problem()
+	if err != nil {
+		return nil, err
+	}
+
+	tableau, err := problem.Compile()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, recorder := matrix.Record(ctx)
+	sol, err := tableau.Solve(ctx, inequations.DoubledMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		Title:     DefaultTitle,
+		Precision: DefaultPrecision,
+		Model:     model,
+		Problem:   tableau.Matrix,
+		Steps:     recorder.Steps(),
+		Solution:  *sol,
+	}, nil
+}
+
+// view is the report prepared for rendering: numbers are rounded and
+// titles are turned into plain text.
+type view struct {
+	Title       string
+	Goal        string
+	Objective   string
+	Constraints []string
+	Problem     table
+	Steps       []step
+	Support     string
+	Optimal     string
+	Dual        string
+	Value       string
+}
+
+type step struct {
+	Index    int
+	Stage    string
+	NewStage bool
+	Pivot    string
+	Row      string
+	Col      string
+	Table    table
+}
+
+type table struct {
+	Header []string
+	Rows   []tableRow
+}
+
+type tableRow struct {
+	Title string
+	Z     bool
+	Cells []tableCell
+}
+
+type tableCell struct {
+	Value string
+	Pivot bool
+}
+
+func (r *Report) view() view {
+	precision := r.Precision
+	if precision <= 0 {
+		precision = DefaultPrecision
+	}
+
+	title := r.Title
+	if title == "" {
+		title = DefaultTitle
+	}
+
+	v := view{
+		Title:       title,
+		Goal:        r.Model.Goal,
+		Objective:   r.Model.Objective,
+		Constraints: r.Model.Constraints,
+		Problem:     newTable(r.Problem, latex.NoPivot, precision),
+		Support:     vector(r.Solution.Support, precision),
+		Optimal:     vector(r.Solution.Optimal, precision),
+		Dual:        vector(r.Solution.Dual, precision),
+		Value:       latex.Number(r.Solution.Objective, precision),
+	}
+
+	for i, s := range r.Steps {
+		before := withTitles(s.Before)
+		v.Steps = append(v.Steps, step{
+			Index:    i + 1,
+			Stage:    latex.StageTitle(s.Stage),
+			NewStage: i == 0 || s.Stage != r.Steps[i-1].Stage,
+			Pivot:    latex.Number(s.Pivot(), precision),
+			Row:      variable(before.LeftTitle[s.Row]),
+			Col:      variable(before.TopTitle[s.Col]),
+			Table:    newTable(before, latex.Cell{Row: s.Row, Col: s.Col}, precision),
+		})
+	}
+
+	return v
+}
+
+func newTable(m matrix.Matrix, pivot latex.Cell, precision int) table {
+	if len(m.Rows) == 0 {
+		return table{}
+	}
+
+	m = withTitles(m)
+	t := table{Header: make([]string, 0, len(m.TopTitle))}
+	for _, v := range m.TopTitle {
+		t.Header = append(t.Header, variable(v))
+	}
+
+	for i, row := range m.Rows {
+		r := tableRow{
+			Title: variable(m.LeftTitle[i]),
+			Z:     m.LeftTitle[i].IsZ(),
+			Cells: make([]tableCell, 0, len(row)),
+		}
+
+		// the objective row of the lp tableau is left without a title.
+		if r.Title == "" && i == len(m.Rows)-1 {
+			r.Title, r.Z = "z", true
+		}
+
+		for j, el := range row {
+			r.Cells = append(r.Cells, tableCell{
+				Value: latex.Number(el, precision),
+				Pivot: i == pivot.Row && j == pivot.Col,
+			})
+		}
+
+		t.Rows = append(t.Rows, r)
+	}
+
+	return t
+}
+
+func withTitles(m matrix.Matrix) matrix.Matrix {
+	if len(m.Rows) == 0 {
+		return m
+	}
+
+	if len(m.LeftTitle) != len(m.Rows) {
+		m.FillLeftTitle()
+	}
+
+	if len(m.TopTitle) != len(m.Rows[0]) {
+		m.FillTopTitle()
+	}
+
+	return m
+}
+
+// variable renders the title like y0 u0, the service names (z, w, 1, 0)
+// are rendered without indexes.
+func variable(v matrix.Variable) string {
+	parts := make([]string, 0, 2)
+	for _, p := range []struct {
+		name  string
+		index int
+	}{
+		{v.FirstStageName, v.FirstStageIndex},
+		{v.SecondStageName, v.SecondStageIndex},
+	} {
+		switch p.name {
+		case "":
+		case "z", "w", "1", "0":
+			parts = append(parts, p.name)
+		default:
+			parts = append(parts, fmt.Sprintf("%s%d", p.name, p.index))
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func vector(v []float64, precision int) string {
+	if len(v) == 0 {
+		return "-"
+	}
+
+	els := make([]string, 0, len(v))
+	for _, el := range v {
+		els = append(els, latex.Number(el, precision))
+	}
+
+	return "(" + strings.Join(els, ", ") + ")"
+}
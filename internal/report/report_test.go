@@ -0,0 +1,151 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/workspace"
+)
+
+func TestBuild(t *testing.T) {
+	tc := []struct {
+		name      string
+		model     workspace.Model
+		optimal   []float64
+		objective float64
+		err       error
+	}{
+		{
+			name: "Should solve max model",
+			model: workspace.Model{
+				Objective:   "3x1+2x2",
+				Constraints: []string{"x1+x2<=4", "x1-x2<=2"},
+				Goal:        workspace.MaxGoal,
+			},
+			optimal:   []float64{3, 1},
+			objective: 11,
+		},
+		{
+			name: "Should reject invalid goal",
+			model: workspace.Model{
+				Objective:   "3x1+2x2",
+				Constraints: []string{"x1+x2<=4"},
+				Goal:        "avg",
+			},
+			err: workspace.ErrInvalidGoal,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := Build(context.Background(), tt.model)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(r.Solution.Optimal, tt.optimal) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.optimal, r.Solution.Optimal)
+			}
+
+			if r.Solution.Objective != tt.objective {
+				t.Fatalf("Expected to get: %v, got: %v", tt.objective, r.Solution.Objective)
+			}
+
+			if len(r.Steps) == 0 {
+				t.Fatal("Expected to record solver steps")
+			}
+
+			if r.Problem.Rows[1][0] != 1 || r.Problem.LeftTitle[1].String() != "y1 u1" {
+				t.Fatalf("Expected initial tableau to stay intact, got: %v", r.Problem)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	r, err := Build(context.Background(), workspace.Model{
+		Objective:   "3x1+2x2",
+		Constraints: []string{"x1+x2<=4", "x1-x2<=2"},
+		Goal:        workspace.MaxGoal,
+	})
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	tc := []struct {
+		name     string
+		render   func(*Report, *strings.Builder) error
+		expected []string
+	}{
+		{
+			name:   "Should render markdown",
+			render: func(r *Report, b *strings.Builder) error { return r.Markdown(b) },
+			expected: []string{
+				"# Linear programming solution\n",
+				"max `z = 3x1+2x2`",
+				"- `x1-x2<=2`\n",
+				"| y0 u0 | 1 | 1 | 4 |\n",
+				"| **z** | -3 | -2 | 0 |\n",
+				"## Optimal solution\n",
+				"| y1 u1 | **[1]** | -1 | 2 |\n",
+				"- Optimal solution: (3, 1)\n",
+				"- max z = 11\n",
+			},
+		},
+		{
+			name:   "Should render html",
+			render: func(r *Report, b *strings.Builder) error { return r.HTML(b) },
+			expected: []string{
+				"<!DOCTYPE html>",
+				"<title>Linear programming solution</title>",
+				"<li><code>x1-x2&lt;=2</code></li>",
+				`<tr class="z"><th>z</th><td>-3</td><td>-2</td><td>0</td></tr>`,
+				"<h2>Optimal solution</h2>",
+				`<tr><th>y1 u1</th><td class="pivot">1</td><td>-1</td><td>2</td></tr>`,
+				"<li>Optimal solution: (3, 1)</li>",
+				"<li>max z = 11</li>",
+				"</html>",
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var b strings.Builder
+			if err := tt.render(r, &b); err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			for _, s := range tt.expected {
+				if !strings.Contains(b.String(), s) {
+					t.Fatalf("Expected output to contain: %q, got:\n%s", s, b.String())
+				}
+			}
+		})
+	}
+}
+
+func TestHTMLEscapesInput(t *testing.T) {
+	r := &Report{
+		Title: "<script>alert(1)</script>",
+		Model: workspace.Model{Objective: "<b>x1</b>", Goal: workspace.MaxGoal},
+	}
+
+	var b strings.Builder
+	if err := r.HTML(&b); err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	if strings.Contains(b.String(), "<script>") || strings.Contains(b.String(), "<b>") {
+		t.Fatalf("Expected input to be escaped, got:\n%s", b.String())
+	}
+}
@@ -0,0 +1,186 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/matrix"
+	"github.com/hrvadl/algo/internal/qp"
+	"github.com/hrvadl/algo/internal/rpc/solverpb"
+)
+
+var ErrEmptyMatrix = errors.New("matrix should not be empty")
+
+func toMatrix(m *solverpb.Matrix) matrix.Matrix {
+	res := matrix.Matrix{
+		Rows:        make([]matrix.Row, len(m.GetRows())),
+		LeftTitle:   toVariables(m.GetLeftTitle()),
+		TopTitle:    toVariables(m.GetTopTitle()),
+		InitialRows: int(m.GetInitialRows()),
+		InitialCols: int(m.GetInitialCols()),
+	}
+
+	for i, row := range m.GetRows() {
+		res.Rows[i] = append(matrix.Row(nil), row.GetValues()...)
+	}
+
+	return res
+}
+
+func toVariables(vs []*solverpb.Variable) []matrix.Variable {
+	if len(vs) == 0 {
+		return nil
+	}
+
+	res := make([]matrix.Variable, len(vs))
+	for i, v := range vs {
+		res[i] = matrix.Variable{
+			FirstStageName:   v.GetFirstStageName(),
+			FirstStageIndex:  int(v.GetFirstStageIndex()),
+			SecondStageName:  v.GetSecondStageName(),
+			SecondStageIndex: int(v.GetSecondStageIndex()),
+		}
+	}
+
+	return res
+}
+
+func fromMatrix(m matrix.Matrix) *solverpb.Matrix {
+	res := &solverpb.Matrix{
+		Rows:        make([]*solverpb.Row, len(m.Rows)),
+		LeftTitle:   fromVariables(m.LeftTitle),
+		TopTitle:    fromVariables(m.TopTitle),
+		InitialRows: int32(m.InitialRows),
+		InitialCols: int32(m.InitialCols),
+	}
+
+	for i, row := range m.Rows {
+		res.Rows[i] = &solverpb.Row{Values: row}
+	}
+
+	return res
+}
+
+func fromVariables(vs []matrix.Variable) []*solverpb.Variable {
+	if len(vs) == 0 {
+		return nil
+	}
+
+	res := make([]*solverpb.Variable, len(vs))
+	for i, v := range vs {
+		res[i] = &solverpb.Variable{
+			FirstStageName:   v.FirstStageName,
+			FirstStageIndex:  int32(v.FirstStageIndex),
+			SecondStageName:  v.SecondStageName,
+			SecondStageIndex: int32(v.SecondStageIndex),
+		}
+	}
+
+	return res
+}
+
+func fromSolution(s inequations.Solution) *solverpb.Solution {
+	return &solverpb.Solution{
+		Matrix: fromMatrix(s.Matrix),
+		Result: s.Result,
+	}
+}
+
+// fromResult keeps the last tableaus of the solver, the values of the
+// variables are decoded back from the tableau columns, which differ from
+// them for the shifted and free variables.
+func fromResult(goal solverpb.Goal, res *lp.Solution) *solverpb.SolveLinearProblemResponse {
+	support := fromSolution(res.Result.Support)
+	support.Result = res.Support
+	optimal := fromSolution(res.Result.Optimal)
+	optimal.Result = res.Optimal
+
+	out := &solverpb.SolveLinearProblemResponse{
+		Support: support,
+		Dual:    res.Result.Dual,
+	}
+
+	if goal == solverpb.Goal_GOAL_MIN {
+		out.Optimal = &solverpb.SolveLinearProblemResponse_Min{
+			Min: &solverpb.MinSolution{Solution: optimal, Min: res.Objective},
+		}
+	} else {
+		out.Optimal = &solverpb.SolveLinearProblemResponse_Max{
+			Max: &solverpb.MaxSolution{Solution: optimal, Max: res.Objective},
+		}
+	}
+
+	return out
+}
+
+var stages = map[matrix.Stage]solverpb.Stage{
+	inequations.SupportStage:    solverpb.Stage_STAGE_SUPPORT,
+	inequations.OptimalStage:    solverpb.Stage_STAGE_OPTIMAL,
+	inequations.DualStage:       solverpb.Stage_STAGE_DUAL,
+	inequations.CrossoverStage:  solverpb.Stage_STAGE_CROSSOVER,
+	inequations.ParametricStage: solverpb.Stage_STAGE_PARAMETRIC,
+	qp.LemkeStage:               solverpb.Stage_STAGE_LEMKE,
+}
+
+func fromStep(index int, s matrix.PivotStep) *solverpb.PivotStep {
+	return &solverpb.PivotStep{
+		Index:  int32(index),
+		Stage:  stages[s.Stage],
+		Row:    int32(s.Row),
+		Col:    int32(s.Col),
+		Before: fromMatrix(s.Before),
+		After:  fromMatrix(s.After),
+	}
+}
+
+func toMethod(m solverpb.Method) (inequations.Method, error) {
+	switch m {
+	case solverpb.Method_METHOD_UNSPECIFIED, solverpb.Method_METHOD_SIMPLEX:
+		return inequations.SimplexMethod, nil
+	case solverpb.Method_METHOD_INTEGER:
+		return inequations.IntegerMethod, nil
+	case solverpb.Method_METHOD_DOUBLED:
+		return inequations.DoubledMethod, nil
+	case solverpb.Method_METHOD_BOUNDED:
+		return inequations.BoundedMethod, nil
+	case solverpb.Method_METHOD_INTERIOR_POINT:
+		return inequations.InteriorPointMethod, nil
+	}
+
+	return 0, inequations.ErrUnknownMethod
+}
+
+func toInts(s []int) []int32 {
+	res := make([]int32, len(s))
+	for i, v := range s {
+		res[i] = int32(v)
+	}
+	return res
+}
+
+func validateMatrix(m matrix.Matrix, maxSize int) error {
+	if len(m.Rows) == 0 || len(m.Rows[0]) == 0 {
+		return ErrEmptyMatrix
+	}
+
+	if len(m.Rows) > maxSize || len(m.Rows[0]) > maxSize {
+		return fmt.Errorf("matrix should not be larger than %[1]dx%[1]d", maxSize)
+	}
+
+	for i, row := range m.Rows {
+		if len(row) != len(m.Rows[0]) {
+			return fmt.Errorf("row %d should have %d columns", i, len(m.Rows[0]))
+		}
+
+		for _, el := range row {
+			if math.IsInf(el, 0) || math.IsNaN(el) {
+				return fmt.Errorf("row %d should contain only finite numbers", i)
+			}
+		}
+	}
+
+	return nil
+}
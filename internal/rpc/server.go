@@ -0,0 +1,516 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hrvadl/algo/internal/equations"
+	"github.com/hrvadl/algo/internal/games"
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/matrix"
+	"github.com/hrvadl/algo/internal/rpc/solverpb"
+	"github.com/hrvadl/algo/internal/workspace"
+)
+
+const (
+	DefaultMaxMatrixSize  = 100
+	DefaultMaxSimulations = 10_000_000
+	DefaultMaxConcurrent  = 16
+	DefaultRequestTimeout = 30 * time.Second
+)
+
+type Config struct {
+	// MaxMatrixSize limits both rows and columns of every input matrix.
+	MaxMatrixSize int
+	// MaxSimulations limits the amount of rounds of a game simulation.
+	MaxSimulations int
+	// MaxConcurrent limits the amount of rpcs solved at the same time.
+	MaxConcurrent int
+	// RequestTimeout cancels a solver which takes longer than that.
+	RequestTimeout time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		MaxMatrixSize:  DefaultMaxMatrixSize,
+		MaxSimulations: DefaultMaxSimulations,
+		MaxConcurrent:  DefaultMaxConcurrent,
+		RequestTimeout: DefaultRequestTimeout,
+	}
+}
+
+type Server struct {
+	solverpb.UnimplementedSolverServiceServer
+	cfg   Config
+	slots chan struct{}
+}
+
+func NewServer(cfg Config) *Server {
+	return &Server{
+		cfg:   cfg,
+		slots: make(chan struct{}, max(cfg.MaxConcurrent, 1)),
+	}
+}
+
+// NewGRPCServer returns grpc.Server with the solver service registered,
+// panics of the solvers turned into Internal errors and their steps not
+// printed to the stdout. Rpcs are limited the way Config says.
+func NewGRPCServer(cfg Config, opts ...grpc.ServerOption) *grpc.Server {
+	srv := NewServer(cfg)
+	opts = append(
+		opts,
+		grpc.ChainUnaryInterceptor(recoverUnary, srv.limitUnary, quietUnary),
+		grpc.ChainStreamInterceptor(recoverStream, srv.limitStream, quietStream),
+	)
+
+	s := grpc.NewServer(opts...)
+	solverpb.RegisterSolverServiceServer(s, srv)
+	return s
+}
+
+func (s *Server) InvertMatrix(
+	ctx context.Context,
+	req *solverpb.InvertMatrixRequest,
+) (*solverpb.InvertMatrixResponse, error) {
+	m, err := s.matrix(req.GetMatrix())
+	if err != nil {
+		return nil, err
+	}
+
+	if cols, rows := m.GetDimensions(); cols != rows {
+		return nil, invalid(errors.New("matrix should be square"))
+	}
+
+	inverted, err := m.Invert(ctx)
+	if err != nil {
+		return nil, solverError(err)
+	}
+
+	return &solverpb.InvertMatrixResponse{Matrix: fromMatrix(inverted)}, nil
+}
+
+func (s *Server) GetRank(
+	ctx context.Context,
+	req *solverpb.GetRankRequest,
+) (*solverpb.GetRankResponse, error) {
+	m, err := s.matrix(req.GetMatrix())
+	if err != nil {
+		return nil, err
+	}
+
+	rank, err := m.Rank(ctx)
+	if err != nil {
+		return nil, solverError(err)
+	}
+
+	return &solverpb.GetRankResponse{Rank: int32(rank)}, nil
+}
+
+func (s *Server) SolveEquationSystem(
+	ctx context.Context,
+	req *solverpb.SolveEquationSystemRequest,
+) (*solverpb.SolveEquationSystemResponse, error) {
+	a, err := s.matrix(req.GetA())
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.matrix(req.GetB())
+	if err != nil {
+		return nil, err
+	}
+
+	if cols, rows := a.GetDimensions(); cols != rows {
+		return nil, invalid(errors.New("matrix A should be square"))
+	}
+
+	if cols, rows := b.GetDimensions(); cols != 1 || rows != len(a.Rows) {
+		return nil, invalid(fmt.Errorf("matrix B should be %dx1", len(a.Rows)))
+	}
+
+	x, err := equations.SolveSystem(ctx, equations.EquationSystem{A: a, B: b})
+	if err != nil {
+		return nil, solverError(err)
+	}
+
+	return &solverpb.SolveEquationSystemResponse{X: x}, nil
+}
+
+func (s *Server) SolveLinearProblem(
+	ctx context.Context,
+	req *solverpb.SolveLinearProblemRequest,
+) (*solverpb.SolveLinearProblemResponse, error) {
+	return s.solveLinearProblem(ctx, req.GetProblem(), req.GetMethod())
+}
+
+func (s *Server) StreamPivots(
+	req *solverpb.StreamPivotsRequest,
+	stream solverpb.SolverService_StreamPivotsServer,
+) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var (
+		index   int
+		sendErr error
+	)
+
+	ctx = matrix.WithPivotObserver(ctx, func(step matrix.PivotStep) {
+		if sendErr != nil {
+			return
+		}
+
+		sendErr = stream.Send(&solverpb.StreamPivotsResponse{
+			Event: &solverpb.StreamPivotsResponse_Step{Step: fromStep(index, step)},
+		})
+		if sendErr != nil {
+			cancel()
+		}
+		index++
+	})
+
+	res, err := s.solveLinearProblem(ctx, req.GetProblem(), req.GetMethod())
+	if sendErr != nil {
+		return sendErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&solverpb.StreamPivotsResponse{
+		Event: &solverpb.StreamPivotsResponse_Result{Result: res},
+	})
+}
+
+func (s *Server) solveLinearProblem(
+	ctx context.Context,
+	p *solverpb.LinearProblem,
+	method solverpb.Method,
+) (*solverpb.SolveLinearProblemResponse, error) {
+	if n := len(p.GetConstraints()); n == 0 || n > s.cfg.MaxMatrixSize {
+		return nil, invalid(fmt.Errorf("amount of constraints should be in [1; %d]", s.cfg.MaxMatrixSize))
+	}
+
+	m, err := toMethod(method)
+	if err != nil {
+		return nil, invalid(err)
+	}
+
+	model := workspace.Model{
+		Objective:   p.GetObjective(),
+		Constraints: p.GetConstraints(),
+		Bounds:      p.GetBounds(),
+	}
+
+	switch p.GetGoal() {
+	case solverpb.Goal_GOAL_MAX:
+		model.Goal = workspace.MaxGoal
+	case solverpb.Goal_GOAL_MIN:
+		model.Goal = workspace.MinGoal
+	default:
+		return nil, invalid(workspace.ErrInvalidGoal)
+	}
+
+	problem, err := model.Problem()
+	if err != nil {
+		return nil, invalid(err)
+	}
+
+	compile := problem.Compile
+	if m == inequations.BoundedMethod {
+		compile = problem.CompileBounded
+	}
+
+	tableau, err := compile()
+	if err != nil {
+		return nil, invalid(err)
+	}
+
+	if len(tableau.Matrix.Rows[0]) > s.cfg.MaxMatrixSize+1 {
+		return nil, invalid(fmt.Errorf("amount of variables should not exceed %d", s.cfg.MaxMatrixSize))
+	}
+
+	res, err := tableau.Solve(ctx, m)
+	if err != nil {
+		return nil, solverError(err)
+	}
+
+	return fromResult(p.GetGoal(), res), nil
+}
+
+func (s *Server) SolveZeroSumGame(
+	ctx context.Context,
+	req *solverpb.SolveZeroSumGameRequest,
+) (*solverpb.SolveZeroSumGameResponse, error) {
+	m, err := s.matrix(req.GetMatrix())
+	if err != nil {
+		return nil, err
+	}
+
+	solution, err := games.SolveZeroSum(ctx, m)
+	if err != nil {
+		return nil, solverError(err)
+	}
+
+	res := &solverpb.SolveZeroSumGameResponse{
+		GameWeight:           solution.GameWeight,
+		FirstPlayerStrategy:  solution.FirstPlayerStrategy,
+		SecondPlayerStrategy: solution.SecondPlayerStrategy,
+	}
+
+	if clean := solution.Clean; clean != nil {
+		res.Clean = &solverpb.CleanSolution{
+			Row:   int32(clean.Row),
+			Col:   int32(clean.Col),
+			Value: clean.Val,
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Server) SolveNatureGame(
+	_ context.Context,
+	req *solverpb.SolveNatureGameRequest,
+) (*solverpb.SolveNatureGameResponse, error) {
+	m, err := s.matrix(req.GetMatrix())
+	if err != nil {
+		return nil, err
+	}
+
+	p := req.GetP()
+	if req.GetNormalize() {
+		if p, err = games.NormalizeProbabilities(p); err != nil {
+			return nil, invalid(err)
+		}
+	}
+
+	comparison, err := games.CompareCriteria(m, games.CriteriaOptions{
+		Y:      req.GetY(),
+		Lambda: req.GetLambda(),
+		P:      p,
+	})
+	if err != nil {
+		return nil, invalid(err)
+	}
+
+	intervals, err := games.HurwiczIntervals(m)
+	if err != nil {
+		return nil, solverError(err)
+	}
+
+	res := &solverpb.SolveNatureGameResponse{
+		Criteria:         make([]*solverpb.CriterionScores, 0, len(comparison.Criteria)),
+		HurwiczIntervals: make([]*solverpb.DecisionInterval, 0, len(intervals)),
+	}
+
+	for _, c := range comparison.Criteria {
+		res.Criteria = append(res.Criteria, &solverpb.CriterionScores{
+			Name:     c.Name,
+			Scores:   c.Scores,
+			Best:     toInts(c.Best),
+			Minimize: c.Minimize,
+		})
+	}
+
+	for _, i := range intervals {
+		res.HurwiczIntervals = append(res.HurwiczIntervals, &solverpb.DecisionInterval{
+			From:       i.From,
+			To:         i.To,
+			Strategies: toInts(i.Strategies),
+		})
+	}
+
+	return res, nil
+}
+
+func (s *Server) SimulateGame(
+	ctx context.Context,
+	req *solverpb.SimulateGameRequest,
+) (*solverpb.SimulateGameResponse, error) {
+	m, err := s.matrix(req.GetMatrix())
+	if err != nil {
+		return nil, err
+	}
+
+	times := int(req.GetTimes())
+	if times <= 0 || times > s.cfg.MaxSimulations {
+		return nil, invalid(fmt.Errorf("times should be in [1; %d]", s.cfg.MaxSimulations))
+	}
+
+	first, second := req.GetFirstPlayerStrategy(), req.GetSecondPlayerStrategy()
+	if len(first) == 0 && len(second) == 0 {
+		solution, err := games.SolveZeroSum(ctx, m)
+		if err != nil {
+			return nil, solverError(err)
+		}
+		first, second = solution.FirstPlayerStrategy, solution.SecondPlayerStrategy
+	} else {
+		if err := games.ValidateProbabilities(first, len(m.Rows)); err != nil {
+			return nil, invalid(fmt.Errorf("first player strategy: %w", err))
+		}
+
+		if err := games.ValidateProbabilities(second, len(m.Rows[0])); err != nil {
+			return nil, invalid(fmt.Errorf("second player strategy: %w", err))
+		}
+	}
+
+	summary, err := games.SummarizeGame(ctx, games.SimulationOptions{
+		Matrix:               m,
+		FirstPlayerStrategy:  first,
+		SecondPlayerStrategy: second,
+		Times:                times,
+		Seed:                 req.Seed,
+		Workers:              runtime.NumCPU(),
+	})
+	if err != nil {
+		return nil, solverError(err)
+	}
+
+	return &solverpb.SimulateGameResponse{
+		Times:                int32(summary.Times),
+		Mean:                 summary.Mean,
+		Variance:             summary.Variance,
+		StdErr:               summary.StdErr,
+		ConfidenceLow:        summary.ConfidenceLow,
+		ConfidenceHigh:       summary.ConfidenceHigh,
+		FirstPlayerStrategy:  first,
+		SecondPlayerStrategy: second,
+	}, nil
+}
+
+func (s *Server) matrix(pb *solverpb.Matrix) (matrix.Matrix, error) {
+	m := toMatrix(pb)
+	if err := validateMatrix(m, s.cfg.MaxMatrixSize); err != nil {
+		return matrix.Matrix{}, invalid(err)
+	}
+
+	return m, nil
+}
+
+func invalid(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func solverError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	return status.Error(codes.FailedPrecondition, err.Error())
+}
+
+func recoverUnary(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (res any, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = status.Errorf(codes.Internal, "solver failed: %v", rec)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+func recoverStream(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = status.Errorf(codes.Internal, "solver failed: %v", rec)
+		}
+	}()
+
+	return handler(srv, ss)
+}
+
+// quietUnary stops the solvers from printing the steps, the output of
+// concurrent requests would interleave.
+func quietUnary(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(matrix.Quiet(ctx), req)
+}
+
+func quietStream(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, contextStream{ServerStream: ss, ctx: matrix.Quiet(ss.Context())})
+}
+
+// contextStream replaces the context of the wrapped stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// limitUnary rejects the rpc when MaxConcurrent ones are already solved
+// and cancels it after RequestTimeout.
+func (s *Server) limitUnary(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	release, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.RequestTimeout)
+	defer cancel()
+
+	return handler(ctx, req)
+}
+
+func (s *Server) limitStream(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	release, err := s.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ss.Context(), s.cfg.RequestTimeout)
+	defer cancel()
+
+	return handler(srv, contextStream{ServerStream: ss, ctx: ctx})
+}
+
+func (s *Server) acquire() (func(), error) {
+	select {
+	case s.slots <- struct{}{}:
+		return func() { <-s.slots }, nil
+	default:
+		return nil, status.Error(codes.ResourceExhausted, "too many requests in progress")
+	}
+}
@@ -0,0 +1,516 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"math"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/rpc/solverpb"
+)
+
+const bufSize = 1 << 20
+
+func newClient(t *testing.T) solverpb.SolverServiceClient {
+	t.Helper()
+	return newClientWith(t, DefaultConfig())
+}
+
+func newClientWith(t *testing.T, cfg Config) solverpb.SolverServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(bufSize)
+	srv := NewGRPCServer(cfg)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return solverpb.NewSolverServiceClient(conn)
+}
+
+func newMatrix(rows ...[]float64) *solverpb.Matrix {
+	m := &solverpb.Matrix{}
+	for _, row := range rows {
+		m.Rows = append(m.Rows, &solverpb.Row{Values: row})
+	}
+	return m
+}
+
+func TestInvertMatrix(t *testing.T) {
+	tc := []struct {
+		name     string
+		m        *solverpb.Matrix
+		expected [][]float64
+		code     codes.Code
+	}{
+		{
+			name:     "Should invert matrix",
+			m:        newMatrix([]float64{1, 2}, []float64{3, 4}),
+			expected: [][]float64{{-2, 1}, {1.5, -0.5}},
+		},
+		{
+			name: "Should reject non square matrix",
+			m:    newMatrix([]float64{1, 2, 3}, []float64{3, 4, 5}),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Should reject ragged matrix",
+			m:    newMatrix([]float64{1, 2}, []float64{3}),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Should reject empty matrix",
+			m:    newMatrix(),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Should fail on degenerate matrix",
+			m:    newMatrix([]float64{1, 2}, []float64{2, 4}),
+			code: codes.FailedPrecondition,
+		},
+	}
+
+	client := newClient(t)
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := client.InvertMatrix(context.Background(), &solverpb.InvertMatrixRequest{Matrix: tt.m})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("Expected to get code: %v, got: %v", tt.code, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got := rows(res.GetMatrix()); !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSolveEquationSystem(t *testing.T) {
+	client := newClient(t)
+	res, err := client.SolveEquationSystem(context.Background(), &solverpb.SolveEquationSystemRequest{
+		A: newMatrix([]float64{1, 1}, []float64{1, -1}),
+		B: newMatrix([]float64{3}, []float64{1}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []float64{2, 1}; !reflect.DeepEqual(res.GetX(), expected) {
+		t.Fatalf("Expected to get: %v, got: %v", expected, res.GetX())
+	}
+}
+
+func TestSolveLinearProblem(t *testing.T) {
+	tc := []struct {
+		name      string
+		req       *solverpb.SolveLinearProblemRequest
+		solution  []float64
+		objective float64
+		dual      []float64
+		code      codes.Code
+	}{
+		{
+			name: "Should solve max problem",
+			req: &solverpb.SolveLinearProblemRequest{
+				Problem: &solverpb.LinearProblem{
+					Objective:   "3x1+2x2",
+					Constraints: []string{"x1+x2<=4", "x1-x2<=2"},
+					Goal:        solverpb.Goal_GOAL_MAX,
+				},
+			},
+			solution:  []float64{3, 1},
+			objective: 11,
+		},
+		{
+			name: "Should solve doubled max problem",
+			req: &solverpb.SolveLinearProblemRequest{
+				Problem: &solverpb.LinearProblem{
+					Objective:   "3x1+2x2",
+					Constraints: []string{"x1+x2<=4", "x1-x2<=2"},
+					Goal:        solverpb.Goal_GOAL_MAX,
+				},
+				Method: solverpb.Method_METHOD_DOUBLED,
+			},
+			solution:  []float64{3, 1},
+			objective: 11,
+			dual:      []float64{2.5, 0.5, 0},
+		},
+		{
+			name: "Should solve min problem",
+			req: &solverpb.SolveLinearProblemRequest{
+				Problem: &solverpb.LinearProblem{
+					Objective:   "3x1+2x2",
+					Constraints: []string{"x1+x2>=4", "x1-x2<=2"},
+					Goal:        solverpb.Goal_GOAL_MIN,
+				},
+			},
+			solution:  []float64{0, 4},
+			objective: 8,
+		},
+		{
+			name: "Should solve bounded max problem",
+			req: &solverpb.SolveLinearProblemRequest{
+				Problem: &solverpb.LinearProblem{
+					Objective:   "x1+x2",
+					Constraints: []string{"x1+x2<=5"},
+					Bounds:      []string{"2 <= x1 <= 10", "-1 <= x2 <= 1"},
+					Goal:        solverpb.Goal_GOAL_MAX,
+				},
+				Method: solverpb.Method_METHOD_BOUNDED,
+			},
+			solution:  []float64{6, -1},
+			objective: 5,
+		},
+		{
+			name: "Should solve max problem with interior point method",
+			req: &solverpb.SolveLinearProblemRequest{
+				Problem: &solverpb.LinearProblem{
+					Objective:   "3x1+2x2",
+					Constraints: []string{"x1+x2<=4", "x1-x2<=2"},
+					Goal:        solverpb.Goal_GOAL_MAX,
+				},
+				Method: solverpb.Method_METHOD_INTERIOR_POINT,
+			},
+			solution:  []float64{3, 1},
+			objective: 11,
+			dual:      []float64{2.5, 0.5, 0},
+		},
+		{
+			name: "Should reject unspecified goal",
+			req: &solverpb.SolveLinearProblemRequest{
+				Problem: &solverpb.LinearProblem{
+					Objective:   "3x1+2x2",
+					Constraints: []string{"x1+x2<=4"},
+				},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Should reject invalid constraint",
+			req: &solverpb.SolveLinearProblemRequest{
+				Problem: &solverpb.LinearProblem{
+					Objective:   "3x1+2x2",
+					Constraints: []string{"x1+x2"},
+					Goal:        solverpb.Goal_GOAL_MAX,
+				},
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	client := newClient(t)
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := client.SolveLinearProblem(context.Background(), tt.req)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("Expected to get code: %v, got: %v", tt.code, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			solution, objective := optimal(res)
+			if !reflect.DeepEqual(solution, tt.solution) || objective != tt.objective {
+				t.Fatalf(
+					"Expected to get: %v %v, got: %v %v",
+					tt.solution,
+					tt.objective,
+					solution,
+					objective,
+				)
+			}
+
+			if !reflect.DeepEqual(res.GetDual(), tt.dual) {
+				t.Fatalf("Expected to get dual: %v, got: %v", tt.dual, res.GetDual())
+			}
+		})
+	}
+}
+
+func TestStreamPivots(t *testing.T) {
+	client := newClient(t)
+	steps, result := streamPivots(t, client, &solverpb.StreamPivotsRequest{
+		Problem: &solverpb.LinearProblem{
+			Objective:   "3x1+2x2",
+			Constraints: []string{"x1+x2<=4", "x1-x2<=2"},
+			Goal:        solverpb.Goal_GOAL_MAX,
+		},
+	})
+
+	if len(steps) == 0 {
+		t.Fatalf("Expected to get pivot steps")
+	}
+
+	for i, step := range steps {
+		if int(step.GetIndex()) != i {
+			t.Fatalf("Expected step index to be: %d, got: %d", i, step.GetIndex())
+		}
+
+		pivot := step.GetBefore().GetRows()[step.GetRow()].GetValues()[step.GetCol()]
+		if pivot == 0 {
+			t.Fatalf("Expected pivot element of step %d not to be zero", i)
+		}
+	}
+
+	last := steps[len(steps)-1].GetAfter()
+	if !reflect.DeepEqual(rows(last), rows(result.GetMax().GetSolution().GetMatrix())) {
+		t.Fatalf("Expected last pivot to produce the optimal tableau")
+	}
+
+	if result.GetMax().GetMax() != 11 {
+		t.Fatalf("Expected to get: %v, got: %v", 11, result.GetMax().GetMax())
+	}
+}
+
+func TestStreamPivotsStages(t *testing.T) {
+	client := newClient(t)
+	tc := []struct {
+		name   string
+		method solverpb.Method
+		stage  solverpb.Stage
+		max    float64
+	}{
+		{
+			name:   "Should label cut pivots of integer method as dual",
+			method: solverpb.Method_METHOD_INTEGER,
+			stage:  solverpb.Stage_STAGE_DUAL,
+			max:    4,
+		},
+		{
+			name:   "Should label vertex pivots of interior point method as crossover",
+			method: solverpb.Method_METHOD_INTERIOR_POINT,
+			stage:  solverpb.Stage_STAGE_CROSSOVER,
+			max:    4.5,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			steps, result := streamPivots(t, client, &solverpb.StreamPivotsRequest{
+				Problem: &solverpb.LinearProblem{
+					Objective:   "x1+x2",
+					Constraints: []string{"2x1+2x2<=9", "x1<=3"},
+					Goal:        solverpb.Goal_GOAL_MAX,
+				},
+				Method: tt.method,
+			})
+
+			var found bool
+			for _, step := range steps {
+				if step.GetStage() == solverpb.Stage_STAGE_UNSPECIFIED {
+					t.Fatalf("Expected step %d to have the stage", step.GetIndex())
+				}
+				found = found || step.GetStage() == tt.stage
+			}
+
+			if !found {
+				t.Fatalf("Expected to get steps of stage: %v", tt.stage)
+			}
+
+			if math.Abs(result.GetMax().GetMax()-tt.max) > lp.Tolerance {
+				t.Fatalf("Expected to get: %v, got: %v", tt.max, result.GetMax().GetMax())
+			}
+		})
+	}
+}
+
+func streamPivots(
+	t *testing.T,
+	client solverpb.SolverServiceClient,
+	req *solverpb.StreamPivotsRequest,
+) ([]*solverpb.PivotStep, *solverpb.SolveLinearProblemResponse) {
+	t.Helper()
+	stream, err := client.StreamPivots(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		steps  []*solverpb.PivotStep
+		result *solverpb.SolveLinearProblemResponse
+	)
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return steps, result
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result != nil {
+			t.Fatalf("Expected result to be the last message, got: %v", msg)
+		}
+
+		if step := msg.GetStep(); step != nil {
+			steps = append(steps, step)
+			continue
+		}
+
+		result = msg.GetResult()
+	}
+}
+
+func TestSolveZeroSumGame(t *testing.T) {
+	client := newClient(t)
+	res, err := client.SolveZeroSumGame(context.Background(), &solverpb.SolveZeroSumGameRequest{
+		Matrix: newMatrix([]float64{1, -1}, []float64{-1, 1}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []float64{0.5, 0.5}
+	if !reflect.DeepEqual(res.GetFirstPlayerStrategy(), expected) ||
+		!reflect.DeepEqual(res.GetSecondPlayerStrategy(), expected) ||
+		res.GetGameWeight() != 0 ||
+		res.GetClean() != nil {
+		t.Fatalf("Expected to get mixed strategies: %v, got: %v", expected, res)
+	}
+}
+
+func TestSolveNatureGame(t *testing.T) {
+	client := newClient(t)
+	res, err := client.SolveNatureGame(context.Background(), &solverpb.SolveNatureGameRequest{
+		Matrix:    newMatrix([]float64{1, 3}, []float64{2, 2}),
+		Y:         0.5,
+		Lambda:    0.5,
+		P:         []float64{1, 1},
+		Normalize: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.GetCriteria()) == 0 || len(res.GetHurwiczIntervals()) == 0 {
+		t.Fatalf("Expected to get criteria and intervals, got: %v", res)
+	}
+
+	_, err = client.SolveNatureGame(context.Background(), &solverpb.SolveNatureGameRequest{
+		Matrix: newMatrix([]float64{1, 3}, []float64{2, 2}),
+		P:      []float64{1, 1},
+	})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Fatalf("Expected to get code: %v, got: %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestSimulateGame(t *testing.T) {
+	client := newClient(t)
+	req := &solverpb.SimulateGameRequest{
+		Matrix: newMatrix([]float64{1, -1}, []float64{-1, 1}),
+		Times:  500,
+		Seed:   proto.Int64(42),
+	}
+
+	first, err := client.SimulateGame(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := client.SimulateGame(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first.GetMean() != second.GetMean() || first.GetTimes() != 500 {
+		t.Fatalf("Expected simulations with the same seed to be equal: %v != %v", first, second)
+	}
+
+	req.Times = 0
+	if _, err := client.SimulateGame(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected to get code: %v, got: %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	req := &solverpb.SolveLinearProblemRequest{
+		Problem: &solverpb.LinearProblem{
+			Objective:   "3x1+2x2",
+			Constraints: []string{"x1+x2<=4"},
+			Goal:        solverpb.Goal_GOAL_MAX,
+		},
+	}
+
+	client := newClientWith(t, Config{
+		MaxMatrixSize:  DefaultMaxMatrixSize,
+		MaxSimulations: DefaultMaxSimulations,
+		MaxConcurrent:  DefaultMaxConcurrent,
+		RequestTimeout: time.Nanosecond,
+	})
+
+	if _, err := client.SolveLinearProblem(context.Background(), req); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("Expected to get code: %v, got: %v", codes.DeadlineExceeded, err)
+	}
+
+	stream, err := client.StreamPivots(context.Background(), &solverpb.StreamPivotsRequest{Problem: req.Problem})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := stream.Recv(); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("Expected to get code: %v, got: %v", codes.DeadlineExceeded, err)
+	}
+
+	srv := NewServer(Config{MaxConcurrent: 1, RequestTimeout: time.Minute})
+	nested := func(ctx context.Context, req any) (any, error) {
+		return srv.limitUnary(ctx, req, nil, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+	}
+
+	if _, err := srv.limitUnary(context.Background(), req, nil, nested); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Expected to get code: %v, got: %v", codes.ResourceExhausted, err)
+	}
+
+	if _, err := nested(context.Background(), req); err != nil {
+		t.Fatalf("Expected slot to be released, got: %v", err)
+	}
+}
+
+func rows(m *solverpb.Matrix) [][]float64 {
+	res := make([][]float64, 0, len(m.GetRows()))
+	for _, row := range m.GetRows() {
+		res = append(res, row.GetValues())
+	}
+	return res
+}
+
+func optimal(res *solverpb.SolveLinearProblemResponse) ([]float64, float64) {
+	if m := res.GetMin(); m != nil {
+		return m.GetSolution().GetResult(), m.GetMin()
+	}
+
+	return res.GetMax().GetSolution().GetResult(), res.GetMax().GetMax()
+}
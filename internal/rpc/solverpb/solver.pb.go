@@ -0,0 +1,2677 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: solver/v1/solver.proto
+
+package solverpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Goal int32
+
+const (
+	Goal_GOAL_UNSPECIFIED Goal = 0
+	Goal_GOAL_MAX         Goal = 1
+	Goal_GOAL_MIN         Goal = 2
+)
+
+// Enum value maps for Goal.
+var (
+	Goal_name = map[int32]string{
+		0: "GOAL_UNSPECIFIED",
+		1: "GOAL_MAX",
+		2: "GOAL_MIN",
+	}
+	Goal_value = map[string]int32{
+		"GOAL_UNSPECIFIED": 0,
+		"GOAL_MAX":         1,
+		"GOAL_MIN":         2,
+	}
+)
+
+func (x Goal) Enum() *Goal {
+	p := new(Goal)
+	*p = x
+	return p
+}
+
+func (x Goal) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Goal) Descriptor() protoreflect.EnumDescriptor {
+	return file_solver_v1_solver_proto_enumTypes[0].Descriptor()
+}
+
+func (Goal) Type() protoreflect.EnumType {
+	return &file_solver_v1_solver_proto_enumTypes[0]
+}
+
+func (x Goal) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Goal.Descriptor instead.
+func (Goal) EnumDescriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{0}
+}
+
+type Method int32
+
+const (
+	Method_METHOD_UNSPECIFIED    Method = 0
+	Method_METHOD_SIMPLEX        Method = 1
+	Method_METHOD_INTEGER        Method = 2
+	Method_METHOD_DOUBLED        Method = 3
+	Method_METHOD_BOUNDED        Method = 4
+	Method_METHOD_INTERIOR_POINT Method = 5
+)
+
+// Enum value maps for Method.
+var (
+	Method_name = map[int32]string{
+		0: "METHOD_UNSPECIFIED",
+		1: "METHOD_SIMPLEX",
+		2: "METHOD_INTEGER",
+		3: "METHOD_DOUBLED",
+		4: "METHOD_BOUNDED",
+		5: "METHOD_INTERIOR_POINT",
+	}
+	Method_value = map[string]int32{
+		"METHOD_UNSPECIFIED":    0,
+		"METHOD_SIMPLEX":        1,
+		"METHOD_INTEGER":        2,
+		"METHOD_DOUBLED":        3,
+		"METHOD_BOUNDED":        4,
+		"METHOD_INTERIOR_POINT": 5,
+	}
+)
+
+func (x Method) Enum() *Method {
+	p := new(Method)
+	*p = x
+	return p
+}
+
+func (x Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_solver_v1_solver_proto_enumTypes[1].Descriptor()
+}
+
+func (Method) Type() protoreflect.EnumType {
+	return &file_solver_v1_solver_proto_enumTypes[1]
+}
+
+func (x Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Method.Descriptor instead.
+func (Method) EnumDescriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{1}
+}
+
+// Stage is the solver stage the pivot belongs to: the dual simplex after
+// the integer method cuts or the crossover of the interior point method.
+type Stage int32
+
+const (
+	Stage_STAGE_UNSPECIFIED Stage = 0
+	Stage_STAGE_SUPPORT     Stage = 1
+	Stage_STAGE_OPTIMAL     Stage = 2
+	Stage_STAGE_DUAL        Stage = 3
+	Stage_STAGE_CROSSOVER   Stage = 4
+	Stage_STAGE_PARAMETRIC  Stage = 5
+	Stage_STAGE_LEMKE       Stage = 6
+)
+
+// Enum value maps for Stage.
+var (
+	Stage_name = map[int32]string{
+		0: "STAGE_UNSPECIFIED",
+		1: "STAGE_SUPPORT",
+		2: "STAGE_OPTIMAL",
+		3: "STAGE_DUAL",
+		4: "STAGE_CROSSOVER",
+		5: "STAGE_PARAMETRIC",
+		6: "STAGE_LEMKE",
+	}
+	Stage_value = map[string]int32{
+		"STAGE_UNSPECIFIED": 0,
+		"STAGE_SUPPORT":     1,
+		"STAGE_OPTIMAL":     2,
+		"STAGE_DUAL":        3,
+		"STAGE_CROSSOVER":   4,
+		"STAGE_PARAMETRIC":  5,
+		"STAGE_LEMKE":       6,
+	}
+)
+
+func (x Stage) Enum() *Stage {
+	p := new(Stage)
+	*p = x
+	return p
+}
+
+func (x Stage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Stage) Descriptor() protoreflect.EnumDescriptor {
+	return file_solver_v1_solver_proto_enumTypes[2].Descriptor()
+}
+
+func (Stage) Type() protoreflect.EnumType {
+	return &file_solver_v1_solver_proto_enumTypes[2]
+}
+
+func (x Stage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Stage.Descriptor instead.
+func (Stage) EnumDescriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{2}
+}
+
+// Variable mirrors matrix.Variable, e.g. "y0 u0" or "x1 v1".
+type Variable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstStageName   string `protobuf:"bytes,1,opt,name=first_stage_name,json=firstStageName,proto3" json:"first_stage_name,omitempty"`
+	FirstStageIndex  int32  `protobuf:"varint,2,opt,name=first_stage_index,json=firstStageIndex,proto3" json:"first_stage_index,omitempty"`
+	SecondStageName  string `protobuf:"bytes,3,opt,name=second_stage_name,json=secondStageName,proto3" json:"second_stage_name,omitempty"`
+	SecondStageIndex int32  `protobuf:"varint,4,opt,name=second_stage_index,json=secondStageIndex,proto3" json:"second_stage_index,omitempty"`
+}
+
+func (x *Variable) Reset() {
+	*x = Variable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Variable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variable) ProtoMessage() {}
+
+func (x *Variable) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
+func (*Variable) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Variable) GetFirstStageName() string {
+	if x != nil {
+		return x.FirstStageName
+	}
+	return ""
+}
+
+func (x *Variable) GetFirstStageIndex() int32 {
+	if x != nil {
+		return x.FirstStageIndex
+	}
+	return 0
+}
+
+func (x *Variable) GetSecondStageName() string {
+	if x != nil {
+		return x.SecondStageName
+	}
+	return ""
+}
+
+func (x *Variable) GetSecondStageIndex() int32 {
+	if x != nil {
+		return x.SecondStageIndex
+	}
+	return 0
+}
+
+type Row struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *Row) Reset() {
+	*x = Row{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Row) ProtoMessage() {}
+
+func (x *Row) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Row.ProtoReflect.Descriptor instead.
+func (*Row) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Row) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Matrix mirrors matrix.Matrix, titles are only set for simplex tableaux.
+type Matrix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows        []*Row      `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	LeftTitle   []*Variable `protobuf:"bytes,2,rep,name=left_title,json=leftTitle,proto3" json:"left_title,omitempty"`
+	TopTitle    []*Variable `protobuf:"bytes,3,rep,name=top_title,json=topTitle,proto3" json:"top_title,omitempty"`
+	InitialRows int32       `protobuf:"varint,4,opt,name=initial_rows,json=initialRows,proto3" json:"initial_rows,omitempty"`
+	InitialCols int32       `protobuf:"varint,5,opt,name=initial_cols,json=initialCols,proto3" json:"initial_cols,omitempty"`
+}
+
+func (x *Matrix) Reset() {
+	*x = Matrix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Matrix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Matrix) ProtoMessage() {}
+
+func (x *Matrix) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Matrix.ProtoReflect.Descriptor instead.
+func (*Matrix) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Matrix) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *Matrix) GetLeftTitle() []*Variable {
+	if x != nil {
+		return x.LeftTitle
+	}
+	return nil
+}
+
+func (x *Matrix) GetTopTitle() []*Variable {
+	if x != nil {
+		return x.TopTitle
+	}
+	return nil
+}
+
+func (x *Matrix) GetInitialRows() int32 {
+	if x != nil {
+		return x.InitialRows
+	}
+	return 0
+}
+
+func (x *Matrix) GetInitialCols() int32 {
+	if x != nil {
+		return x.InitialCols
+	}
+	return 0
+}
+
+type Solution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matrix *Matrix   `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	Result []float64 `protobuf:"fixed64,2,rep,packed,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *Solution) Reset() {
+	*x = Solution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Solution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Solution) ProtoMessage() {}
+
+func (x *Solution) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Solution.ProtoReflect.Descriptor instead.
+func (*Solution) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Solution) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *Solution) GetResult() []float64 {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type MaxSolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Solution *Solution `protobuf:"bytes,1,opt,name=solution,proto3" json:"solution,omitempty"`
+	Max      float64   `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *MaxSolution) Reset() {
+	*x = MaxSolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaxSolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxSolution) ProtoMessage() {}
+
+func (x *MaxSolution) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaxSolution.ProtoReflect.Descriptor instead.
+func (*MaxSolution) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MaxSolution) GetSolution() *Solution {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+func (x *MaxSolution) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type MinSolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Solution *Solution `protobuf:"bytes,1,opt,name=solution,proto3" json:"solution,omitempty"`
+	Min      float64   `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+}
+
+func (x *MinSolution) Reset() {
+	*x = MinSolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinSolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinSolution) ProtoMessage() {}
+
+func (x *MinSolution) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinSolution.ProtoReflect.Descriptor instead.
+func (*MinSolution) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MinSolution) GetSolution() *Solution {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+func (x *MinSolution) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+// LinearProblem uses the same expression syntax as the cli, e.g.
+// objective "3x1+2x2", constraints "x1+x2<=4" and bounds "x1 <= 3" or
+// "x2 free". Variables without bounds are non-negative.
+type LinearProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Objective   string   `protobuf:"bytes,1,opt,name=objective,proto3" json:"objective,omitempty"`
+	Constraints []string `protobuf:"bytes,2,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	Goal        Goal     `protobuf:"varint,3,opt,name=goal,proto3,enum=solver.v1.Goal" json:"goal,omitempty"`
+	Bounds      []string `protobuf:"bytes,4,rep,name=bounds,proto3" json:"bounds,omitempty"`
+}
+
+func (x *LinearProblem) Reset() {
+	*x = LinearProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinearProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinearProblem) ProtoMessage() {}
+
+func (x *LinearProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinearProblem.ProtoReflect.Descriptor instead.
+func (*LinearProblem) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LinearProblem) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *LinearProblem) GetConstraints() []string {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *LinearProblem) GetGoal() Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return Goal_GOAL_UNSPECIFIED
+}
+
+func (x *LinearProblem) GetBounds() []string {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+type InvertMatrixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matrix *Matrix `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+}
+
+func (x *InvertMatrixRequest) Reset() {
+	*x = InvertMatrixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvertMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvertMatrixRequest) ProtoMessage() {}
+
+func (x *InvertMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvertMatrixRequest.ProtoReflect.Descriptor instead.
+func (*InvertMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InvertMatrixRequest) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+type InvertMatrixResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matrix *Matrix `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+}
+
+func (x *InvertMatrixResponse) Reset() {
+	*x = InvertMatrixResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvertMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvertMatrixResponse) ProtoMessage() {}
+
+func (x *InvertMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvertMatrixResponse.ProtoReflect.Descriptor instead.
+func (*InvertMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InvertMatrixResponse) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+type GetRankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matrix *Matrix `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+}
+
+func (x *GetRankRequest) Reset() {
+	*x = GetRankRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankRequest) ProtoMessage() {}
+
+func (x *GetRankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankRequest.ProtoReflect.Descriptor instead.
+func (*GetRankRequest) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetRankRequest) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+type GetRankResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank int32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *GetRankResponse) Reset() {
+	*x = GetRankResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankResponse) ProtoMessage() {}
+
+func (x *GetRankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankResponse.ProtoReflect.Descriptor instead.
+func (*GetRankResponse) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRankResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type SolveEquationSystemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A *Matrix `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B *Matrix `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+}
+
+func (x *SolveEquationSystemRequest) Reset() {
+	*x = SolveEquationSystemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveEquationSystemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveEquationSystemRequest) ProtoMessage() {}
+
+func (x *SolveEquationSystemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveEquationSystemRequest.ProtoReflect.Descriptor instead.
+func (*SolveEquationSystemRequest) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SolveEquationSystemRequest) GetA() *Matrix {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *SolveEquationSystemRequest) GetB() *Matrix {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+type SolveEquationSystemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X []float64 `protobuf:"fixed64,1,rep,packed,name=x,proto3" json:"x,omitempty"`
+}
+
+func (x *SolveEquationSystemResponse) Reset() {
+	*x = SolveEquationSystemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveEquationSystemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveEquationSystemResponse) ProtoMessage() {}
+
+func (x *SolveEquationSystemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveEquationSystemResponse.ProtoReflect.Descriptor instead.
+func (*SolveEquationSystemResponse) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SolveEquationSystemResponse) GetX() []float64 {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+type SolveLinearProblemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Problem *LinearProblem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
+	Method  Method         `protobuf:"varint,2,opt,name=method,proto3,enum=solver.v1.Method" json:"method,omitempty"`
+}
+
+func (x *SolveLinearProblemRequest) Reset() {
+	*x = SolveLinearProblemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveLinearProblemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveLinearProblemRequest) ProtoMessage() {}
+
+func (x *SolveLinearProblemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveLinearProblemRequest.ProtoReflect.Descriptor instead.
+func (*SolveLinearProblemRequest) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SolveLinearProblemRequest) GetProblem() *LinearProblem {
+	if x != nil {
+		return x.Problem
+	}
+	return nil
+}
+
+func (x *SolveLinearProblemRequest) GetMethod() Method {
+	if x != nil {
+		return x.Method
+	}
+	return Method_METHOD_UNSPECIFIED
+}
+
+type StreamPivotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Problem *LinearProblem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
+	Method  Method         `protobuf:"varint,2,opt,name=method,proto3,enum=solver.v1.Method" json:"method,omitempty"`
+}
+
+func (x *StreamPivotsRequest) Reset() {
+	*x = StreamPivotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPivotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPivotsRequest) ProtoMessage() {}
+
+func (x *StreamPivotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPivotsRequest.ProtoReflect.Descriptor instead.
+func (*StreamPivotsRequest) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StreamPivotsRequest) GetProblem() *LinearProblem {
+	if x != nil {
+		return x.Problem
+	}
+	return nil
+}
+
+func (x *StreamPivotsRequest) GetMethod() Method {
+	if x != nil {
+		return x.Method
+	}
+	return Method_METHOD_UNSPECIFIED
+}
+
+type SolveLinearProblemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Support *Solution `protobuf:"bytes,1,opt,name=support,proto3" json:"support,omitempty"`
+	// Types that are assignable to Optimal:
+	//	*SolveLinearProblemResponse_Max
+	//	*SolveLinearProblemResponse_Min
+	Optimal isSolveLinearProblemResponse_Optimal `protobuf_oneof:"optimal"`
+	// dual is only set for METHOD_DOUBLED.
+	Dual []float64 `protobuf:"fixed64,4,rep,packed,name=dual,proto3" json:"dual,omitempty"`
+}
+
+func (x *SolveLinearProblemResponse) Reset() {
+	*x = SolveLinearProblemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveLinearProblemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveLinearProblemResponse) ProtoMessage() {}
+
+func (x *SolveLinearProblemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveLinearProblemResponse.ProtoReflect.Descriptor instead.
+func (*SolveLinearProblemResponse) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SolveLinearProblemResponse) GetSupport() *Solution {
+	if x != nil {
+		return x.Support
+	}
+	return nil
+}
+
+func (m *SolveLinearProblemResponse) GetOptimal() isSolveLinearProblemResponse_Optimal {
+	if m != nil {
+		return m.Optimal
+	}
+	return nil
+}
+
+func (x *SolveLinearProblemResponse) GetMax() *MaxSolution {
+	if x, ok := x.GetOptimal().(*SolveLinearProblemResponse_Max); ok {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *SolveLinearProblemResponse) GetMin() *MinSolution {
+	if x, ok := x.GetOptimal().(*SolveLinearProblemResponse_Min); ok {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *SolveLinearProblemResponse) GetDual() []float64 {
+	if x != nil {
+		return x.Dual
+	}
+	return nil
+}
+
+type isSolveLinearProblemResponse_Optimal interface {
+	isSolveLinearProblemResponse_Optimal()
+}
+
+type SolveLinearProblemResponse_Max struct {
+	Max *MaxSolution `protobuf:"bytes,2,opt,name=max,proto3,oneof"`
+}
+
+type SolveLinearProblemResponse_Min struct {
+	Min *MinSolution `protobuf:"bytes,3,opt,name=min,proto3,oneof"`
+}
+
+func (*SolveLinearProblemResponse_Max) isSolveLinearProblemResponse_Optimal() {}
+
+func (*SolveLinearProblemResponse_Min) isSolveLinearProblemResponse_Optimal() {}
+
+type PivotStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  int32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Stage  Stage   `protobuf:"varint,2,opt,name=stage,proto3,enum=solver.v1.Stage" json:"stage,omitempty"`
+	Row    int32   `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Col    int32   `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
+	Before *Matrix `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After  *Matrix `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *PivotStep) Reset() {
+	*x = PivotStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PivotStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PivotStep) ProtoMessage() {}
+
+func (x *PivotStep) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PivotStep.ProtoReflect.Descriptor instead.
+func (*PivotStep) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PivotStep) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PivotStep) GetStage() Stage {
+	if x != nil {
+		return x.Stage
+	}
+	return Stage_STAGE_UNSPECIFIED
+}
+
+func (x *PivotStep) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *PivotStep) GetCol() int32 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *PivotStep) GetBefore() *Matrix {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PivotStep) GetAfter() *Matrix {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type StreamPivotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*StreamPivotsResponse_Step
+	//	*StreamPivotsResponse_Result
+	Event isStreamPivotsResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *StreamPivotsResponse) Reset() {
+	*x = StreamPivotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPivotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPivotsResponse) ProtoMessage() {}
+
+func (x *StreamPivotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPivotsResponse.ProtoReflect.Descriptor instead.
+func (*StreamPivotsResponse) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *StreamPivotsResponse) GetEvent() isStreamPivotsResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *StreamPivotsResponse) GetStep() *PivotStep {
+	if x, ok := x.GetEvent().(*StreamPivotsResponse_Step); ok {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *StreamPivotsResponse) GetResult() *SolveLinearProblemResponse {
+	if x, ok := x.GetEvent().(*StreamPivotsResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isStreamPivotsResponse_Event interface {
+	isStreamPivotsResponse_Event()
+}
+
+type StreamPivotsResponse_Step struct {
+	Step *PivotStep `protobuf:"bytes,1,opt,name=step,proto3,oneof"`
+}
+
+type StreamPivotsResponse_Result struct {
+	Result *SolveLinearProblemResponse `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*StreamPivotsResponse_Step) isStreamPivotsResponse_Event() {}
+
+func (*StreamPivotsResponse_Result) isStreamPivotsResponse_Event() {}
+
+type CleanSolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row   int32   `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Col   int32   `protobuf:"varint,2,opt,name=col,proto3" json:"col,omitempty"`
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *CleanSolution) Reset() {
+	*x = CleanSolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanSolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanSolution) ProtoMessage() {}
+
+func (x *CleanSolution) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanSolution.ProtoReflect.Descriptor instead.
+func (*CleanSolution) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CleanSolution) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *CleanSolution) GetCol() int32 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *CleanSolution) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SolveZeroSumGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matrix *Matrix `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+}
+
+func (x *SolveZeroSumGameRequest) Reset() {
+	*x = SolveZeroSumGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveZeroSumGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveZeroSumGameRequest) ProtoMessage() {}
+
+func (x *SolveZeroSumGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveZeroSumGameRequest.ProtoReflect.Descriptor instead.
+func (*SolveZeroSumGameRequest) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SolveZeroSumGameRequest) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+type SolveZeroSumGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// clean is set when the game has a saddle point.
+	Clean                *CleanSolution `protobuf:"bytes,1,opt,name=clean,proto3" json:"clean,omitempty"`
+	GameWeight           float64        `protobuf:"fixed64,2,opt,name=game_weight,json=gameWeight,proto3" json:"game_weight,omitempty"`
+	FirstPlayerStrategy  []float64      `protobuf:"fixed64,3,rep,packed,name=first_player_strategy,json=firstPlayerStrategy,proto3" json:"first_player_strategy,omitempty"`
+	SecondPlayerStrategy []float64      `protobuf:"fixed64,4,rep,packed,name=second_player_strategy,json=secondPlayerStrategy,proto3" json:"second_player_strategy,omitempty"`
+}
+
+func (x *SolveZeroSumGameResponse) Reset() {
+	*x = SolveZeroSumGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveZeroSumGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveZeroSumGameResponse) ProtoMessage() {}
+
+func (x *SolveZeroSumGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveZeroSumGameResponse.ProtoReflect.Descriptor instead.
+func (*SolveZeroSumGameResponse) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SolveZeroSumGameResponse) GetClean() *CleanSolution {
+	if x != nil {
+		return x.Clean
+	}
+	return nil
+}
+
+func (x *SolveZeroSumGameResponse) GetGameWeight() float64 {
+	if x != nil {
+		return x.GameWeight
+	}
+	return 0
+}
+
+func (x *SolveZeroSumGameResponse) GetFirstPlayerStrategy() []float64 {
+	if x != nil {
+		return x.FirstPlayerStrategy
+	}
+	return nil
+}
+
+func (x *SolveZeroSumGameResponse) GetSecondPlayerStrategy() []float64 {
+	if x != nil {
+		return x.SecondPlayerStrategy
+	}
+	return nil
+}
+
+type SolveNatureGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matrix    *Matrix   `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	Y         float64   `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Lambda    float64   `protobuf:"fixed64,3,opt,name=lambda,proto3" json:"lambda,omitempty"`
+	P         []float64 `protobuf:"fixed64,4,rep,packed,name=p,proto3" json:"p,omitempty"`
+	Normalize bool      `protobuf:"varint,5,opt,name=normalize,proto3" json:"normalize,omitempty"`
+}
+
+func (x *SolveNatureGameRequest) Reset() {
+	*x = SolveNatureGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveNatureGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveNatureGameRequest) ProtoMessage() {}
+
+func (x *SolveNatureGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveNatureGameRequest.ProtoReflect.Descriptor instead.
+func (*SolveNatureGameRequest) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SolveNatureGameRequest) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *SolveNatureGameRequest) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SolveNatureGameRequest) GetLambda() float64 {
+	if x != nil {
+		return x.Lambda
+	}
+	return 0
+}
+
+func (x *SolveNatureGameRequest) GetP() []float64 {
+	if x != nil {
+		return x.P
+	}
+	return nil
+}
+
+func (x *SolveNatureGameRequest) GetNormalize() bool {
+	if x != nil {
+		return x.Normalize
+	}
+	return false
+}
+
+type CriterionScores struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scores   []float64 `protobuf:"fixed64,2,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	Best     []int32   `protobuf:"varint,3,rep,packed,name=best,proto3" json:"best,omitempty"`
+	Minimize bool      `protobuf:"varint,4,opt,name=minimize,proto3" json:"minimize,omitempty"`
+}
+
+func (x *CriterionScores) Reset() {
+	*x = CriterionScores{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriterionScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionScores) ProtoMessage() {}
+
+func (x *CriterionScores) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionScores.ProtoReflect.Descriptor instead.
+func (*CriterionScores) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CriterionScores) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CriterionScores) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *CriterionScores) GetBest() []int32 {
+	if x != nil {
+		return x.Best
+	}
+	return nil
+}
+
+func (x *CriterionScores) GetMinimize() bool {
+	if x != nil {
+		return x.Minimize
+	}
+	return false
+}
+
+type DecisionInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From       float64 `protobuf:"fixed64,1,opt,name=from,proto3" json:"from,omitempty"`
+	To         float64 `protobuf:"fixed64,2,opt,name=to,proto3" json:"to,omitempty"`
+	Strategies []int32 `protobuf:"varint,3,rep,packed,name=strategies,proto3" json:"strategies,omitempty"`
+}
+
+func (x *DecisionInterval) Reset() {
+	*x = DecisionInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecisionInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecisionInterval) ProtoMessage() {}
+
+func (x *DecisionInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecisionInterval.ProtoReflect.Descriptor instead.
+func (*DecisionInterval) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DecisionInterval) GetFrom() float64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DecisionInterval) GetTo() float64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *DecisionInterval) GetStrategies() []int32 {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+type SolveNatureGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Criteria         []*CriterionScores  `protobuf:"bytes,1,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	HurwiczIntervals []*DecisionInterval `protobuf:"bytes,2,rep,name=hurwicz_intervals,json=hurwiczIntervals,proto3" json:"hurwicz_intervals,omitempty"`
+}
+
+func (x *SolveNatureGameResponse) Reset() {
+	*x = SolveNatureGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveNatureGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveNatureGameResponse) ProtoMessage() {}
+
+func (x *SolveNatureGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveNatureGameResponse.ProtoReflect.Descriptor instead.
+func (*SolveNatureGameResponse) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SolveNatureGameResponse) GetCriteria() []*CriterionScores {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *SolveNatureGameResponse) GetHurwiczIntervals() []*DecisionInterval {
+	if x != nil {
+		return x.HurwiczIntervals
+	}
+	return nil
+}
+
+type SimulateGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matrix *Matrix `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	// Strategies are solved from the matrix when both are empty.
+	FirstPlayerStrategy  []float64 `protobuf:"fixed64,2,rep,packed,name=first_player_strategy,json=firstPlayerStrategy,proto3" json:"first_player_strategy,omitempty"`
+	SecondPlayerStrategy []float64 `protobuf:"fixed64,3,rep,packed,name=second_player_strategy,json=secondPlayerStrategy,proto3" json:"second_player_strategy,omitempty"`
+	Times                int32     `protobuf:"varint,4,opt,name=times,proto3" json:"times,omitempty"`
+	// The simulation is seeded with the current time when it's unset.
+	Seed *int64 `protobuf:"varint,5,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
+}
+
+func (x *SimulateGameRequest) Reset() {
+	*x = SimulateGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateGameRequest) ProtoMessage() {}
+
+func (x *SimulateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateGameRequest.ProtoReflect.Descriptor instead.
+func (*SimulateGameRequest) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SimulateGameRequest) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *SimulateGameRequest) GetFirstPlayerStrategy() []float64 {
+	if x != nil {
+		return x.FirstPlayerStrategy
+	}
+	return nil
+}
+
+func (x *SimulateGameRequest) GetSecondPlayerStrategy() []float64 {
+	if x != nil {
+		return x.SecondPlayerStrategy
+	}
+	return nil
+}
+
+func (x *SimulateGameRequest) GetTimes() int32 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
+func (x *SimulateGameRequest) GetSeed() int64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+type SimulateGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Times                int32     `protobuf:"varint,1,opt,name=times,proto3" json:"times,omitempty"`
+	Mean                 float64   `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	Variance             float64   `protobuf:"fixed64,3,opt,name=variance,proto3" json:"variance,omitempty"`
+	StdErr               float64   `protobuf:"fixed64,4,opt,name=std_err,json=stdErr,proto3" json:"std_err,omitempty"`
+	ConfidenceLow        float64   `protobuf:"fixed64,5,opt,name=confidence_low,json=confidenceLow,proto3" json:"confidence_low,omitempty"`
+	ConfidenceHigh       float64   `protobuf:"fixed64,6,opt,name=confidence_high,json=confidenceHigh,proto3" json:"confidence_high,omitempty"`
+	FirstPlayerStrategy  []float64 `protobuf:"fixed64,7,rep,packed,name=first_player_strategy,json=firstPlayerStrategy,proto3" json:"first_player_strategy,omitempty"`
+	SecondPlayerStrategy []float64 `protobuf:"fixed64,8,rep,packed,name=second_player_strategy,json=secondPlayerStrategy,proto3" json:"second_player_strategy,omitempty"`
+}
+
+func (x *SimulateGameResponse) Reset() {
+	*x = SimulateGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_v1_solver_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateGameResponse) ProtoMessage() {}
+
+func (x *SimulateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_v1_solver_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateGameResponse.ProtoReflect.Descriptor instead.
+func (*SimulateGameResponse) Descriptor() ([]byte, []int) {
+	return file_solver_v1_solver_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SimulateGameResponse) GetTimes() int32 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
+func (x *SimulateGameResponse) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *SimulateGameResponse) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *SimulateGameResponse) GetStdErr() float64 {
+	if x != nil {
+		return x.StdErr
+	}
+	return 0
+}
+
+func (x *SimulateGameResponse) GetConfidenceLow() float64 {
+	if x != nil {
+		return x.ConfidenceLow
+	}
+	return 0
+}
+
+func (x *SimulateGameResponse) GetConfidenceHigh() float64 {
+	if x != nil {
+		return x.ConfidenceHigh
+	}
+	return 0
+}
+
+func (x *SimulateGameResponse) GetFirstPlayerStrategy() []float64 {
+	if x != nil {
+		return x.FirstPlayerStrategy
+	}
+	return nil
+}
+
+func (x *SimulateGameResponse) GetSecondPlayerStrategy() []float64 {
+	if x != nil {
+		return x.SecondPlayerStrategy
+	}
+	return nil
+}
+
+var File_solver_v1_solver_proto protoreflect.FileDescriptor
+
+var file_solver_v1_solver_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x22, 0xba, 0x01, 0x0a, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x1d, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0xd8, 0x01, 0x0a, 0x06, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x22, 0x0a, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x32,
+	0x0a, 0x0a, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x73, 0x22, 0x4d, 0x0a, 0x08, 0x53, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x50, 0x0a, 0x0b, 0x4d, 0x61, 0x78,
+	0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x50, 0x0a, 0x0b, 0x4d,
+	0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x22, 0x8c, 0x01,
+	0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12,
+	0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x23, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x04,
+	0x67, 0x6f, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x13,
+	0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x22, 0x41,
+	0x0a, 0x14, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x22, 0x3b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x22, 0x25,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x5e, 0x0a, 0x1a, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x71,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x52, 0x01, 0x61, 0x12, 0x1f, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x52, 0x01, 0x62, 0x22, 0x2b, 0x0a, 0x1b, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x71,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x01, 0x78, 0x22, 0x7a, 0x0a, 0x19, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61,
+	0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e,
+	0x65, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x74,
+	0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x1a, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69,
+	0x6e, 0x65, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x2a, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x78, 0x53,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x2a,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x75,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x04, 0x64, 0x75, 0x61, 0x6c, 0x42, 0x09,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x22, 0xc1, 0x01, 0x0a, 0x09, 0x50, 0x69,
+	0x76, 0x6f, 0x74, 0x53, 0x74, 0x65, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x8c, 0x01,
+	0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x65, 0x70, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74,
+	0x65, 0x70, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x0d,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x6f,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x44, 0x0a, 0x17, 0x53, 0x6f, 0x6c, 0x76, 0x65,
+	0x5a, 0x65, 0x72, 0x6f, 0x53, 0x75, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x22, 0xd5, 0x01,
+	0x0a, 0x18, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x53, 0x75, 0x6d, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x53, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x67, 0x61, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x13, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x34, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x6d,
+	0x62, 0x64, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6c, 0x61, 0x6d, 0x62, 0x64,
+	0x61, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x01, 0x70, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x22, 0x6d, 0x0a,
+	0x0f, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x62, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x22, 0x56, 0x0a, 0x10,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x69, 0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x17, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x08,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x48, 0x0a, 0x11, 0x68, 0x75, 0x72, 0x77,
+	0x69, 0x63, 0x7a, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x52, 0x10, 0x68, 0x75, 0x72, 0x77, 0x69, 0x63, 0x7a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x13, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x32, 0x0a, 0x15, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x13, 0x66, 0x69, 0x72, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x22, 0xaf, 0x02, 0x0a, 0x14, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x65, 0x72,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64, 0x45, 0x72, 0x72, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x6f,
+	0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x67, 0x68, 0x12,
+	0x32, 0x0a, 0x15, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x01, 0x52, 0x13,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2a, 0x38, 0x0a, 0x04, 0x47, 0x6f, 0x61,
+	0x6c, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x4f, 0x41, 0x4c, 0x5f,
+	0x4d, 0x41, 0x58, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x4d, 0x49,
+	0x4e, 0x10, 0x02, 0x2a, 0x8b, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16,
+	0x0a, 0x12, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
+	0x5f, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x58, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x45,
+	0x54, 0x48, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x42, 0x4f, 0x55,
+	0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x49, 0x4f, 0x52, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10,
+	0x05, 0x2a, 0x90, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53,
+	0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x55, 0x50, 0x50,
+	0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4f,
+	0x50, 0x54, 0x49, 0x4d, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x47,
+	0x45, 0x5f, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x47,
+	0x45, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x04, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x52, 0x49,
+	0x43, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4c, 0x45, 0x4d,
+	0x4b, 0x45, 0x10, 0x06, 0x32, 0xc6, 0x05, 0x0a, 0x0d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x6e, 0x6b, 0x12, 0x19, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x45, 0x71, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x45, 0x71, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x71, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x61, 0x0a, 0x12, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69, 0x6e,
+	0x65, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x69, 0x76, 0x6f,
+	0x74, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x10, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x5a, 0x65,
+	0x72, 0x6f, 0x53, 0x75, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x53,
+	0x75, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x5a,
+	0x65, 0x72, 0x6f, 0x53, 0x75, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2e, 0x5a,
+	0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x72, 0x76, 0x61,
+	0x64, 0x6c, 0x2f, 0x61, 0x6c, 0x67, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_solver_v1_solver_proto_rawDescOnce sync.Once
+	file_solver_v1_solver_proto_rawDescData = file_solver_v1_solver_proto_rawDesc
+)
+
+func file_solver_v1_solver_proto_rawDescGZIP() []byte {
+	file_solver_v1_solver_proto_rawDescOnce.Do(func() {
+		file_solver_v1_solver_proto_rawDescData = protoimpl.X.CompressGZIP(file_solver_v1_solver_proto_rawDescData)
+	})
+	return file_solver_v1_solver_proto_rawDescData
+}
+
+var file_solver_v1_solver_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_solver_v1_solver_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_solver_v1_solver_proto_goTypes = []interface{}{
+	(Goal)(0),                           // 0: solver.v1.Goal
+	(Method)(0),                         // 1: solver.v1.Method
+	(Stage)(0),                          // 2: solver.v1.Stage
+	(*Variable)(nil),                    // 3: solver.v1.Variable
+	(*Row)(nil),                         // 4: solver.v1.Row
+	(*Matrix)(nil),                      // 5: solver.v1.Matrix
+	(*Solution)(nil),                    // 6: solver.v1.Solution
+	(*MaxSolution)(nil),                 // 7: solver.v1.MaxSolution
+	(*MinSolution)(nil),                 // 8: solver.v1.MinSolution
+	(*LinearProblem)(nil),               // 9: solver.v1.LinearProblem
+	(*InvertMatrixRequest)(nil),         // 10: solver.v1.InvertMatrixRequest
+	(*InvertMatrixResponse)(nil),        // 11: solver.v1.InvertMatrixResponse
+	(*GetRankRequest)(nil),              // 12: solver.v1.GetRankRequest
+	(*GetRankResponse)(nil),             // 13: solver.v1.GetRankResponse
+	(*SolveEquationSystemRequest)(nil),  // 14: solver.v1.SolveEquationSystemRequest
+	(*SolveEquationSystemResponse)(nil), // 15: solver.v1.SolveEquationSystemResponse
+	(*SolveLinearProblemRequest)(nil),   // 16: solver.v1.SolveLinearProblemRequest
+	(*StreamPivotsRequest)(nil),         // 17: solver.v1.StreamPivotsRequest
+	(*SolveLinearProblemResponse)(nil),  // 18: solver.v1.SolveLinearProblemResponse
+	(*PivotStep)(nil),                   // 19: solver.v1.PivotStep
+	(*StreamPivotsResponse)(nil),        // 20: solver.v1.StreamPivotsResponse
+	(*CleanSolution)(nil),               // 21: solver.v1.CleanSolution
+	(*SolveZeroSumGameRequest)(nil),     // 22: solver.v1.SolveZeroSumGameRequest
+	(*SolveZeroSumGameResponse)(nil),    // 23: solver.v1.SolveZeroSumGameResponse
+	(*SolveNatureGameRequest)(nil),      // 24: solver.v1.SolveNatureGameRequest
+	(*CriterionScores)(nil),             // 25: solver.v1.CriterionScores
+	(*DecisionInterval)(nil),            // 26: solver.v1.DecisionInterval
+	(*SolveNatureGameResponse)(nil),     // 27: solver.v1.SolveNatureGameResponse
+	(*SimulateGameRequest)(nil),         // 28: solver.v1.SimulateGameRequest
+	(*SimulateGameResponse)(nil),        // 29: solver.v1.SimulateGameResponse
+}
+var file_solver_v1_solver_proto_depIdxs = []int32{
+	4,  // 0: solver.v1.Matrix.rows:type_name -> solver.v1.Row
+	3,  // 1: solver.v1.Matrix.left_title:type_name -> solver.v1.Variable
+	3,  // 2: solver.v1.Matrix.top_title:type_name -> solver.v1.Variable
+	5,  // 3: solver.v1.Solution.matrix:type_name -> solver.v1.Matrix
+	6,  // 4: solver.v1.MaxSolution.solution:type_name -> solver.v1.Solution
+	6,  // 5: solver.v1.MinSolution.solution:type_name -> solver.v1.Solution
+	0,  // 6: solver.v1.LinearProblem.goal:type_name -> solver.v1.Goal
+	5,  // 7: solver.v1.InvertMatrixRequest.matrix:type_name -> solver.v1.Matrix
+	5,  // 8: solver.v1.InvertMatrixResponse.matrix:type_name -> solver.v1.Matrix
+	5,  // 9: solver.v1.GetRankRequest.matrix:type_name -> solver.v1.Matrix
+	5,  // 10: solver.v1.SolveEquationSystemRequest.a:type_name -> solver.v1.Matrix
+	5,  // 11: solver.v1.SolveEquationSystemRequest.b:type_name -> solver.v1.Matrix
+	9,  // 12: solver.v1.SolveLinearProblemRequest.problem:type_name -> solver.v1.LinearProblem
+	1,  // 13: solver.v1.SolveLinearProblemRequest.method:type_name -> solver.v1.Method
+	9,  // 14: solver.v1.StreamPivotsRequest.problem:type_name -> solver.v1.LinearProblem
+	1,  // 15: solver.v1.StreamPivotsRequest.method:type_name -> solver.v1.Method
+	6,  // 16: solver.v1.SolveLinearProblemResponse.support:type_name -> solver.v1.Solution
+	7,  // 17: solver.v1.SolveLinearProblemResponse.max:type_name -> solver.v1.MaxSolution
+	8,  // 18: solver.v1.SolveLinearProblemResponse.min:type_name -> solver.v1.MinSolution
+	2,  // 19: solver.v1.PivotStep.stage:type_name -> solver.v1.Stage
+	5,  // 20: solver.v1.PivotStep.before:type_name -> solver.v1.Matrix
+	5,  // 21: solver.v1.PivotStep.after:type_name -> solver.v1.Matrix
+	19, // 22: solver.v1.StreamPivotsResponse.step:type_name -> solver.v1.PivotStep
+	18, // 23: solver.v1.StreamPivotsResponse.result:type_name -> solver.v1.SolveLinearProblemResponse
+	5,  // 24: solver.v1.SolveZeroSumGameRequest.matrix:type_name -> solver.v1.Matrix
+	21, // 25: solver.v1.SolveZeroSumGameResponse.clean:type_name -> solver.v1.CleanSolution
+	5,  // 26: solver.v1.SolveNatureGameRequest.matrix:type_name -> solver.v1.Matrix
+	25, // 27: solver.v1.SolveNatureGameResponse.criteria:type_name -> solver.v1.CriterionScores
+	26, // 28: solver.v1.SolveNatureGameResponse.hurwicz_intervals:type_name -> solver.v1.DecisionInterval
+	5,  // 29: solver.v1.SimulateGameRequest.matrix:type_name -> solver.v1.Matrix
+	10, // 30: solver.v1.SolverService.InvertMatrix:input_type -> solver.v1.InvertMatrixRequest
+	12, // 31: solver.v1.SolverService.GetRank:input_type -> solver.v1.GetRankRequest
+	14, // 32: solver.v1.SolverService.SolveEquationSystem:input_type -> solver.v1.SolveEquationSystemRequest
+	16, // 33: solver.v1.SolverService.SolveLinearProblem:input_type -> solver.v1.SolveLinearProblemRequest
+	17, // 34: solver.v1.SolverService.StreamPivots:input_type -> solver.v1.StreamPivotsRequest
+	22, // 35: solver.v1.SolverService.SolveZeroSumGame:input_type -> solver.v1.SolveZeroSumGameRequest
+	24, // 36: solver.v1.SolverService.SolveNatureGame:input_type -> solver.v1.SolveNatureGameRequest
+	28, // 37: solver.v1.SolverService.SimulateGame:input_type -> solver.v1.SimulateGameRequest
+	11, // 38: solver.v1.SolverService.InvertMatrix:output_type -> solver.v1.InvertMatrixResponse
+	13, // 39: solver.v1.SolverService.GetRank:output_type -> solver.v1.GetRankResponse
+	15, // 40: solver.v1.SolverService.SolveEquationSystem:output_type -> solver.v1.SolveEquationSystemResponse
+	18, // 41: solver.v1.SolverService.SolveLinearProblem:output_type -> solver.v1.SolveLinearProblemResponse
+	20, // 42: solver.v1.SolverService.StreamPivots:output_type -> solver.v1.StreamPivotsResponse
+	23, // 43: solver.v1.SolverService.SolveZeroSumGame:output_type -> solver.v1.SolveZeroSumGameResponse
+	27, // 44: solver.v1.SolverService.SolveNatureGame:output_type -> solver.v1.SolveNatureGameResponse
+	29, // 45: solver.v1.SolverService.SimulateGame:output_type -> solver.v1.SimulateGameResponse
+	38, // [38:46] is the sub-list for method output_type
+	30, // [30:38] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_solver_v1_solver_proto_init() }
+func file_solver_v1_solver_proto_init() {
+	if File_solver_v1_solver_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_solver_v1_solver_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Row); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Matrix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Solution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaxSolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinSolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinearProblem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvertMatrixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvertMatrixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveEquationSystemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveEquationSystemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveLinearProblemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPivotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveLinearProblemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PivotStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPivotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanSolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveZeroSumGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveZeroSumGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveNatureGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriterionScores); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecisionInterval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveNatureGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solver_v1_solver_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_solver_v1_solver_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*SolveLinearProblemResponse_Max)(nil),
+		(*SolveLinearProblemResponse_Min)(nil),
+	}
+	file_solver_v1_solver_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*StreamPivotsResponse_Step)(nil),
+		(*StreamPivotsResponse_Result)(nil),
+	}
+	file_solver_v1_solver_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_solver_v1_solver_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_solver_v1_solver_proto_goTypes,
+		DependencyIndexes: file_solver_v1_solver_proto_depIdxs,
+		EnumInfos:         file_solver_v1_solver_proto_enumTypes,
+		MessageInfos:      file_solver_v1_solver_proto_msgTypes,
+	}.Build()
+	File_solver_v1_solver_proto = out.File
+	file_solver_v1_solver_proto_rawDesc = nil
+	file_solver_v1_solver_proto_goTypes = nil
+	file_solver_v1_solver_proto_depIdxs = nil
+}
@@ -0,0 +1,414 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: solver/v1/solver.proto
+
+package solverpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	SolverService_InvertMatrix_FullMethodName        = "/solver.v1.SolverService/InvertMatrix"
+	SolverService_GetRank_FullMethodName             = "/solver.v1.SolverService/GetRank"
+	SolverService_SolveEquationSystem_FullMethodName = "/solver.v1.SolverService/SolveEquationSystem"
+	SolverService_SolveLinearProblem_FullMethodName  = "/solver.v1.SolverService/SolveLinearProblem"
+	SolverService_StreamPivots_FullMethodName        = "/solver.v1.SolverService/StreamPivots"
+	SolverService_SolveZeroSumGame_FullMethodName    = "/solver.v1.SolverService/SolveZeroSumGame"
+	SolverService_SolveNatureGame_FullMethodName     = "/solver.v1.SolverService/SolveNatureGame"
+	SolverService_SimulateGame_FullMethodName        = "/solver.v1.SolverService/SimulateGame"
+)
+
+// SolverServiceClient is the client API for SolverService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SolverService exposes the matrix, equation, linear programming and game
+// theory solvers.
+type SolverServiceClient interface {
+	InvertMatrix(ctx context.Context, in *InvertMatrixRequest, opts ...grpc.CallOption) (*InvertMatrixResponse, error)
+	GetRank(ctx context.Context, in *GetRankRequest, opts ...grpc.CallOption) (*GetRankResponse, error)
+	SolveEquationSystem(ctx context.Context, in *SolveEquationSystemRequest, opts ...grpc.CallOption) (*SolveEquationSystemResponse, error)
+	SolveLinearProblem(ctx context.Context, in *SolveLinearProblemRequest, opts ...grpc.CallOption) (*SolveLinearProblemResponse, error)
+	// StreamPivots solves the problem and emits every simplex pivot as it
+	// happens, the last message holds the result.
+	StreamPivots(ctx context.Context, in *StreamPivotsRequest, opts ...grpc.CallOption) (SolverService_StreamPivotsClient, error)
+	SolveZeroSumGame(ctx context.Context, in *SolveZeroSumGameRequest, opts ...grpc.CallOption) (*SolveZeroSumGameResponse, error)
+	SolveNatureGame(ctx context.Context, in *SolveNatureGameRequest, opts ...grpc.CallOption) (*SolveNatureGameResponse, error)
+	SimulateGame(ctx context.Context, in *SimulateGameRequest, opts ...grpc.CallOption) (*SimulateGameResponse, error)
+}
+
+type solverServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSolverServiceClient(cc grpc.ClientConnInterface) SolverServiceClient {
+	return &solverServiceClient{cc}
+}
+
+func (c *solverServiceClient) InvertMatrix(ctx context.Context, in *InvertMatrixRequest, opts ...grpc.CallOption) (*InvertMatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvertMatrixResponse)
+	err := c.cc.Invoke(ctx, SolverService_InvertMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverServiceClient) GetRank(ctx context.Context, in *GetRankRequest, opts ...grpc.CallOption) (*GetRankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRankResponse)
+	err := c.cc.Invoke(ctx, SolverService_GetRank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverServiceClient) SolveEquationSystem(ctx context.Context, in *SolveEquationSystemRequest, opts ...grpc.CallOption) (*SolveEquationSystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveEquationSystemResponse)
+	err := c.cc.Invoke(ctx, SolverService_SolveEquationSystem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverServiceClient) SolveLinearProblem(ctx context.Context, in *SolveLinearProblemRequest, opts ...grpc.CallOption) (*SolveLinearProblemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveLinearProblemResponse)
+	err := c.cc.Invoke(ctx, SolverService_SolveLinearProblem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverServiceClient) StreamPivots(ctx context.Context, in *StreamPivotsRequest, opts ...grpc.CallOption) (SolverService_StreamPivotsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SolverService_ServiceDesc.Streams[0], SolverService_StreamPivots_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &solverServiceStreamPivotsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SolverService_StreamPivotsClient interface {
+	Recv() (*StreamPivotsResponse, error)
+	grpc.ClientStream
+}
+
+type solverServiceStreamPivotsClient struct {
+	grpc.ClientStream
+}
+
+func (x *solverServiceStreamPivotsClient) Recv() (*StreamPivotsResponse, error) {
+	m := new(StreamPivotsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *solverServiceClient) SolveZeroSumGame(ctx context.Context, in *SolveZeroSumGameRequest, opts ...grpc.CallOption) (*SolveZeroSumGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveZeroSumGameResponse)
+	err := c.cc.Invoke(ctx, SolverService_SolveZeroSumGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverServiceClient) SolveNatureGame(ctx context.Context, in *SolveNatureGameRequest, opts ...grpc.CallOption) (*SolveNatureGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveNatureGameResponse)
+	err := c.cc.Invoke(ctx, SolverService_SolveNatureGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverServiceClient) SimulateGame(ctx context.Context, in *SimulateGameRequest, opts ...grpc.CallOption) (*SimulateGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateGameResponse)
+	err := c.cc.Invoke(ctx, SolverService_SimulateGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SolverServiceServer is the server API for SolverService service.
+// All implementations must embed UnimplementedSolverServiceServer
+// for forward compatibility
+//
+// SolverService exposes the matrix, equation, linear programming and game
+// theory solvers.
+type SolverServiceServer interface {
+	InvertMatrix(context.Context, *InvertMatrixRequest) (*InvertMatrixResponse, error)
+	GetRank(context.Context, *GetRankRequest) (*GetRankResponse, error)
+	SolveEquationSystem(context.Context, *SolveEquationSystemRequest) (*SolveEquationSystemResponse, error)
+	SolveLinearProblem(context.Context, *SolveLinearProblemRequest) (*SolveLinearProblemResponse, error)
+	// StreamPivots solves the problem and emits every simplex pivot as it
+	// happens, the last message holds the result.
+	StreamPivots(*StreamPivotsRequest, SolverService_StreamPivotsServer) error
+	SolveZeroSumGame(context.Context, *SolveZeroSumGameRequest) (*SolveZeroSumGameResponse, error)
+	SolveNatureGame(context.Context, *SolveNatureGameRequest) (*SolveNatureGameResponse, error)
+	SimulateGame(context.Context, *SimulateGameRequest) (*SimulateGameResponse, error)
+	mustEmbedUnimplementedSolverServiceServer()
+}
+
+// UnimplementedSolverServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSolverServiceServer struct {
+}
+
+func (UnimplementedSolverServiceServer) InvertMatrix(context.Context, *InvertMatrixRequest) (*InvertMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvertMatrix not implemented")
+}
+func (UnimplementedSolverServiceServer) GetRank(context.Context, *GetRankRequest) (*GetRankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRank not implemented")
+}
+func (UnimplementedSolverServiceServer) SolveEquationSystem(context.Context, *SolveEquationSystemRequest) (*SolveEquationSystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SolveEquationSystem not implemented")
+}
+func (UnimplementedSolverServiceServer) SolveLinearProblem(context.Context, *SolveLinearProblemRequest) (*SolveLinearProblemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SolveLinearProblem not implemented")
+}
+func (UnimplementedSolverServiceServer) StreamPivots(*StreamPivotsRequest, SolverService_StreamPivotsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPivots not implemented")
+}
+func (UnimplementedSolverServiceServer) SolveZeroSumGame(context.Context, *SolveZeroSumGameRequest) (*SolveZeroSumGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SolveZeroSumGame not implemented")
+}
+func (UnimplementedSolverServiceServer) SolveNatureGame(context.Context, *SolveNatureGameRequest) (*SolveNatureGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SolveNatureGame not implemented")
+}
+func (UnimplementedSolverServiceServer) SimulateGame(context.Context, *SimulateGameRequest) (*SimulateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateGame not implemented")
+}
+func (UnimplementedSolverServiceServer) mustEmbedUnimplementedSolverServiceServer() {}
+
+// UnsafeSolverServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SolverServiceServer will
+// result in compilation errors.
+type UnsafeSolverServiceServer interface {
+	mustEmbedUnimplementedSolverServiceServer()
+}
+
+func RegisterSolverServiceServer(s grpc.ServiceRegistrar, srv SolverServiceServer) {
+	s.RegisterService(&SolverService_ServiceDesc, srv)
+}
+
+func _SolverService_InvertMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvertMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServiceServer).InvertMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SolverService_InvertMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServiceServer).InvertMatrix(ctx, req.(*InvertMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolverService_GetRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServiceServer).GetRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SolverService_GetRank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServiceServer).GetRank(ctx, req.(*GetRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolverService_SolveEquationSystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveEquationSystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServiceServer).SolveEquationSystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SolverService_SolveEquationSystem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServiceServer).SolveEquationSystem(ctx, req.(*SolveEquationSystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolverService_SolveLinearProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveLinearProblemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServiceServer).SolveLinearProblem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SolverService_SolveLinearProblem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServiceServer).SolveLinearProblem(ctx, req.(*SolveLinearProblemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolverService_StreamPivots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPivotsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SolverServiceServer).StreamPivots(m, &solverServiceStreamPivotsServer{ServerStream: stream})
+}
+
+type SolverService_StreamPivotsServer interface {
+	Send(*StreamPivotsResponse) error
+	grpc.ServerStream
+}
+
+type solverServiceStreamPivotsServer struct {
+	grpc.ServerStream
+}
+
+func (x *solverServiceStreamPivotsServer) Send(m *StreamPivotsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SolverService_SolveZeroSumGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveZeroSumGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServiceServer).SolveZeroSumGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SolverService_SolveZeroSumGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServiceServer).SolveZeroSumGame(ctx, req.(*SolveZeroSumGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolverService_SolveNatureGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveNatureGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServiceServer).SolveNatureGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SolverService_SolveNatureGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServiceServer).SolveNatureGame(ctx, req.(*SolveNatureGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolverService_SimulateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServiceServer).SimulateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SolverService_SimulateGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServiceServer).SimulateGame(ctx, req.(*SimulateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SolverService_ServiceDesc is the grpc.ServiceDesc for SolverService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SolverService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "solver.v1.SolverService",
+	HandlerType: (*SolverServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InvertMatrix",
+			Handler:    _SolverService_InvertMatrix_Handler,
+		},
+		{
+			MethodName: "GetRank",
+			Handler:    _SolverService_GetRank_Handler,
+		},
+		{
+			MethodName: "SolveEquationSystem",
+			Handler:    _SolverService_SolveEquationSystem_Handler,
+		},
+		{
+			MethodName: "SolveLinearProblem",
+			Handler:    _SolverService_SolveLinearProblem_Handler,
+		},
+		{
+			MethodName: "SolveZeroSumGame",
+			Handler:    _SolverService_SolveZeroSumGame_Handler,
+		},
+		{
+			MethodName: "SolveNatureGame",
+			Handler:    _SolverService_SolveNatureGame_Handler,
+		},
+		{
+			MethodName: "SimulateGame",
+			Handler:    _SolverService_SimulateGame_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPivots",
+			Handler:       _SolverService_StreamPivots_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "solver/v1/solver.proto",
+}
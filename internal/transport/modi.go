@@ -0,0 +1,290 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// maxIterations stops the potentials method if degenerate pivots cycle.
+const maxIterations = 10000
+
+var ErrCycling = errors.New("potentials method did not converge")
+
+type Solution struct {
+	Balanced
+	Method  Method
+	Initial Plan
+	Optimal Plan
+	// U and V are the potentials of the suppliers and the consumers,
+	// U[0] is fixed to zero.
+	U          []float64
+	V          []float64
+	Iterations int
+}
+
+// Solve balances the problem, builds the initial plan with the method and
+// improves it with the potentials (MODI) method.
+func Solve(ctx context.Context, p Problem, method Method) (*Solution, error) {
+	b, err := Balance(p)
+	if err != nil {
+		return nil, err
+	}
+
+	initial, err := InitialPlan(b, method)
+	if err != nil {
+		return nil, err
+	}
+
+	sol, err := Optimize(ctx, b, initial)
+	if err != nil {
+		return nil, err
+	}
+
+	sol.Method = method
+	return sol, nil
+}
+
+// Optimize moves the shipments by the cycles of the basis until every
+// reduced cost c[i][j] - u[i] - v[j] is non-negative.
+func Optimize(ctx context.Context, p Balanced, initial Plan) (*Solution, error) {
+	s := newState(p.Problem)
+	for i := range s.amounts.Rows {
+		copy(s.amounts.Rows[i], initial.Amounts.Rows[i])
+		copy(s.basis[i], initial.Basis[i])
+	}
+
+	sol := &Solution{Balanced: p, Initial: initial}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		u, v := s.potentials()
+		entering, ok := s.entering(u, v)
+		if !ok {
+			sol.Optimal, sol.U, sol.V = s.plan(), u, v
+			return sol, nil
+		}
+
+		if sol.Iterations == maxIterations {
+			return nil, ErrCycling
+		}
+
+		s.pivot(entering)
+		sol.Iterations++
+	}
+}
+
+// completeBasis adds the cheapest zero routes which don't close a cycle
+// until the basis has rows+cols-1 cells, this resolves the degeneracy.
+func (s *state) completeBasis() {
+	rows, cols := len(s.supply), len(s.demand)
+	parent := make([]int, rows+cols)
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(n int) int {
+		if parent[n] != n {
+			parent[n] = find(parent[n])
+		}
+		return parent[n]
+	}
+
+	size := 0
+	cells := make([]Cell, 0, rows*cols)
+	for i := range rows {
+		for j := range cols {
+			if s.basis[i][j] {
+				parent[find(i)] = find(rows + j)
+				size++
+				continue
+			}
+
+			cells = append(cells, Cell{Row: i, Col: j})
+		}
+	}
+
+	slices.SortStableFunc(cells, func(a, b Cell) int {
+		switch ca, cb := s.cost(a.Row, a.Col), s.cost(b.Row, b.Col); {
+		case ca < cb:
+			return -1
+		case ca > cb:
+			return 1
+		}
+		return 0
+	})
+
+	for _, c := range cells {
+		if size == rows+cols-1 {
+			return
+		}
+
+		if a, b := find(c.Row), find(rows+c.Col); a != b {
+			parent[a] = b
+			s.basis[c.Row][c.Col] = true
+			size++
+		}
+	}
+}
+
+// potentials solves u[i] + v[j] = c[i][j] for the basic cells walking
+// the basis tree from the first supplier.
+func (s *state) potentials() ([]float64, []float64) {
+	rows, cols := len(s.supply), len(s.demand)
+	u, v := make([]float64, rows), make([]float64, cols)
+	knownU, knownV := make([]bool, rows), make([]bool, cols)
+	knownU[0] = true
+
+	queue := []int{0}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node < rows {
+			for j := range cols {
+				if s.basis[node][j] && !knownV[j] {
+					v[j], knownV[j] = s.cost(node, j)-u[node], true
+					queue = append(queue, rows+j)
+				}
+			}
+			continue
+		}
+
+		j := node - rows
+		for i := range rows {
+			if s.basis[i][j] && !knownU[i] {
+				u[i], knownU[i] = s.cost(i, j)-v[j], true
+				queue = append(queue, i)
+			}
+		}
+	}
+
+	return u, v
+}
+
+// entering returns the route with the most negative reduced cost.
+func (s *state) entering(u, v []float64) (Cell, bool) {
+	var (
+		best   Cell
+		lowest = -eps
+		ok     bool
+	)
+
+	for i := range s.supply {
+		for j := range s.demand {
+			if s.basis[i][j] {
+				continue
+			}
+
+			if d := s.cost(i, j) - u[i] - v[j]; d < lowest {
+				best, lowest, ok = Cell{Row: i, Col: j}, d, true
+			}
+		}
+	}
+
+	return best, ok
+}
+
+// pivot ships the largest possible amount through the cycle the entering
+// cell closes, one of the emptied cells leaves the basis.
+func (s *state) pivot(entering Cell) {
+	cycle := append([]Cell{entering}, s.path(entering)...)
+
+	theta, leaving := -1., Cell{}
+	for k := 1; k < len(cycle); k += 2 {
+		c := cycle[k]
+		if amount := s.amounts.Rows[c.Row][c.Col]; theta < 0 || amount < theta-eps {
+			theta, leaving = amount, c
+		}
+	}
+
+	for k, c := range cycle {
+		if k%2 == 0 {
+			s.amounts.Rows[c.Row][c.Col] += theta
+			continue
+		}
+
+		row := s.amounts.Rows[c.Row]
+		row[c.Col] = max(row[c.Col]-theta, 0)
+	}
+
+	s.amounts.Rows[leaving.Row][leaving.Col] = 0
+	s.basis[leaving.Row][leaving.Col] = false
+	s.basis[entering.Row][entering.Col] = true
+}
+
+// path returns the basic cells on the way from the consumer of the
+// entering cell back to its supplier.
+func (s *state) path(entering Cell) []Cell {
+	rows, cols := len(s.supply), len(s.demand)
+	prev := make([]int, rows+cols)
+	for i := range prev {
+		prev[i] = -1
+	}
+
+	start, target := rows+entering.Col, entering.Row
+	prev[start] = start
+	queue := []int{start}
+	for len(queue) > 0 && prev[target] == -1 {
+		node := queue[0]
+		queue = queue[1:]
+		for next := range rows + cols {
+			if prev[next] != -1 || !s.adjacent(node, next) {
+				continue
+			}
+
+			prev[next] = node
+			queue = append(queue, next)
+		}
+	}
+
+	var res []Cell
+	for node := target; node != start; node = prev[node] {
+		res = append(res, s.cell(node, prev[node]))
+	}
+
+	slices.Reverse(res)
+	return res
+}
+
+func (s *state) adjacent(a, b int) bool {
+	rows := len(s.supply)
+	if (a < rows) == (b < rows) {
+		return false
+	}
+
+	c := s.cell(a, b)
+	return s.basis[c.Row][c.Col]
+}
+
+// cell converts an edge of the basis tree to the route, nodes below
+// rows are suppliers and the rest are consumers.
+func (s *state) cell(a, b int) Cell {
+	rows := len(s.supply)
+	if a < rows {
+		return Cell{Row: a, Col: b - rows}
+	}
+
+	return Cell{Row: b, Col: a - rows}
+}
+
+func (s *state) plan() Plan {
+	p := Plan{
+		Amounts: matrix.Matrix{Rows: make([]matrix.Row, len(s.amounts.Rows))},
+		Basis:   make([][]bool, len(s.basis)),
+	}
+
+	for i, row := range s.amounts.Rows {
+		p.Amounts.Rows[i] = append(matrix.Row(nil), row...)
+		p.Basis[i] = append([]bool(nil), s.basis[i]...)
+		for j, amount := range row {
+			p.Cost += amount * s.cost(i, j)
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,175 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/inequations"
+	"github.com/hrvadl/algo/internal/lp"
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestSolve(t *testing.T) {
+	tc := []struct {
+		name    string
+		problem Problem
+		cost    float64
+	}{
+		{
+			name:    "Should solve balanced problem",
+			problem: textbook(),
+			cost:    100,
+		},
+		{
+			name: "Should solve problem with surplus",
+			problem: Problem{
+				Supply: []float64{10, 20},
+				Demand: []float64{5, 5, 5},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{1, 2, 3}, {3, 2, 1}}},
+			},
+			cost: 20,
+		},
+		{
+			name: "Should solve problem with shortage",
+			problem: Problem{
+				Supply: []float64{5, 5},
+				Demand: []float64{8, 8},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{1, 2}, {3, 1}}},
+			},
+			cost: 10,
+		},
+		{
+			name: "Should solve degenerate problem",
+			problem: Problem{
+				Supply: []float64{20, 30, 50},
+				Demand: []float64{30, 40, 30},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{4, 6, 8}, {5, 3, 7}, {6, 5, 4}}},
+			},
+			cost: 400,
+		},
+		{
+			name: "Should solve diagonal degenerate problem",
+			problem: Problem{
+				Supply: []float64{10, 10, 10},
+				Demand: []float64{10, 10, 10},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{1, 5, 5}, {5, 1, 5}, {5, 5, 1}}},
+			},
+			cost: 30,
+		},
+	}
+
+	for _, tt := range tc {
+		for _, method := range []Method{NorthwestCorner, MinimumCost, Vogel} {
+			t.Run(fmt.Sprintf("%s with %s", tt.name, method), func(t *testing.T) {
+				t.Parallel()
+				got, err := Solve(context.Background(), tt.problem, method)
+				if err != nil {
+					t.Fatalf("Expected to get no error, got: %v", err)
+				}
+
+				if math.Abs(got.Optimal.Cost-tt.cost) > eps {
+					t.Fatalf("Expected to get: %v, got: %v", tt.cost, got.Optimal.Cost)
+				}
+
+				if got.Optimal.Cost > got.Initial.Cost+eps {
+					t.Fatalf("Expected optimal cost %v not to exceed initial %v", got.Optimal.Cost, got.Initial.Cost)
+				}
+
+				checkPlan(t, got)
+			})
+		}
+	}
+}
+
+// checkPlan verifies that every supply and demand is met and the
+// potentials prove optimality.
+func checkPlan(t *testing.T, s *Solution) {
+	t.Helper()
+	for i, supply := range s.Supply {
+		if shipped := sum(s.Optimal.Amounts.Rows[i]); math.Abs(shipped-supply) > eps {
+			t.Fatalf("Expected supplier %d to ship %v, got: %v", i, supply, shipped)
+		}
+	}
+
+	for j, demand := range s.Demand {
+		var received float64
+		for i := range s.Supply {
+			received += s.Optimal.Amounts.Rows[i][j]
+		}
+
+		if math.Abs(received-demand) > eps {
+			t.Fatalf("Expected consumer %d to receive %v, got: %v", j, demand, received)
+		}
+	}
+
+	if n := basisSize(s.Optimal); n != len(s.Supply)+len(s.Demand)-1 {
+		t.Fatalf("Expected basis to have %d cells, got: %d", len(s.Supply)+len(s.Demand)-1, n)
+	}
+
+	for i := range s.Supply {
+		for j := range s.Demand {
+			d := s.Cost.Rows[i][j] - s.U[i] - s.V[j]
+			if (s.Optimal.Basis[i][j] && math.Abs(d) > eps) || d < -eps {
+				t.Fatalf("Expected reduced cost of (%d,%d) to be valid, got: %v", i, j, d)
+			}
+		}
+	}
+}
+
+func TestSolveMatchesLinearProgram(t *testing.T) {
+	p := textbook()
+	got, err := Solve(context.Background(), p, Vogel)
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	expected, err := lp.Solve(context.Background(), asLinearProblem(p), inequations.SimplexMethod)
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	if math.Abs(got.Optimal.Cost-expected.Objective) > lp.Tolerance {
+		t.Fatalf("Expected to get: %v, got: %v", expected.Objective, got.Optimal.Cost)
+	}
+}
+
+func asLinearProblem(p Problem) lp.Problem {
+	rows, cols := len(p.Supply), len(p.Demand)
+	res := lp.Problem{Sense: lp.Minimize}
+	for i := range rows {
+		for j := range cols {
+			res.Variables = append(res.Variables, fmt.Sprintf("x%d_%d", i+1, j+1))
+			res.Objective = append(res.Objective, p.Cost.Rows[i][j])
+		}
+	}
+
+	for i, supply := range p.Supply {
+		c := lp.Constraint{Coefficients: make([]float64, rows*cols), Relation: lp.LessEqual, RHS: supply}
+		for j := range cols {
+			c.Coefficients[i*cols+j] = 1
+		}
+		res.Constraints = append(res.Constraints, c)
+	}
+
+	for j, demand := range p.Demand {
+		c := lp.Constraint{Coefficients: make([]float64, rows*cols), Relation: lp.GreaterEqual, RHS: demand}
+		for i := range rows {
+			c.Coefficients[i*cols+j] = 1
+		}
+		res.Constraints = append(res.Constraints, c)
+	}
+
+	return res
+}
+
+func TestSolveCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := Solve(ctx, textbook(), NorthwestCorner); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected to get error: %v, got: %v", context.Canceled, err)
+	}
+}
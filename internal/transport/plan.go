@@ -0,0 +1,209 @@
+package transport
+
+import (
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+type Method uint8
+
+const (
+	NorthwestCorner Method = iota
+	MinimumCost
+	Vogel
+)
+
+func (m Method) String() string {
+	switch m {
+	case NorthwestCorner:
+		return "northwest corner"
+	case MinimumCost:
+		return "minimum cost"
+	case Vogel:
+		return "Vogel"
+	}
+
+	return "unknown"
+}
+
+// Plan is a basic feasible plan. Basis always has rows+cols-1 cells,
+// some of them may carry zero amounts when the plan is degenerate.
+type Plan struct {
+	Amounts matrix.Matrix
+	Basis   [][]bool
+	Cost    float64
+}
+
+// Cell points to the route from the supplier Row to the consumer Col.
+type Cell struct {
+	Row int
+	Col int
+}
+
+// InitialPlan builds the first basic plan of the balanced problem.
+func InitialPlan(p Balanced, method Method) (Plan, error) {
+	s := newState(p.Problem)
+	switch method {
+	case NorthwestCorner:
+		s.northwestCorner()
+	case MinimumCost:
+		s.minimumCost()
+	case Vogel:
+		s.vogel()
+	default:
+		return Plan{}, ErrUnknownMethod
+	}
+
+	s.completeBasis()
+	return s.plan(), nil
+}
+
+type state struct {
+	costs   matrix.Matrix
+	supply  []float64
+	demand  []float64
+	amounts matrix.Matrix
+	basis   [][]bool
+}
+
+func newState(p Problem) *state {
+	s := &state{
+		costs:   p.Cost,
+		supply:  append([]float64(nil), p.Supply...),
+		demand:  append([]float64(nil), p.Demand...),
+		amounts: matrix.Matrix{Rows: make([]matrix.Row, len(p.Supply))},
+		basis:   make([][]bool, len(p.Supply)),
+	}
+
+	for i := range s.amounts.Rows {
+		s.amounts.Rows[i] = make(matrix.Row, len(p.Demand))
+		s.basis[i] = make([]bool, len(p.Demand))
+	}
+
+	return s
+}
+
+// allocate ships as much as possible by the route.
+func (s *state) allocate(c Cell) {
+	amount := math.Min(s.supply[c.Row], s.demand[c.Col])
+	s.amounts.Rows[c.Row][c.Col] += amount
+	s.basis[c.Row][c.Col] = true
+	s.supply[c.Row] -= amount
+	s.demand[c.Col] -= amount
+}
+
+func (s *state) northwestCorner() {
+	i, j := 0, 0
+	for i < len(s.supply) && j < len(s.demand) {
+		s.allocate(Cell{Row: i, Col: j})
+		// when both are exhausted the degenerate zero is added by
+		// completeBasis later.
+		rowDone, colDone := s.supply[i] <= eps, s.demand[j] <= eps
+		if rowDone {
+			i++
+		}
+
+		if colDone {
+			j++
+		}
+	}
+}
+
+func (s *state) minimumCost() {
+	for {
+		best, ok := Cell{}, false
+		for i := range s.supply {
+			for j := range s.demand {
+				if !s.isOpen(i, j) {
+					continue
+				}
+
+				if !ok || s.cost(i, j) < s.cost(best.Row, best.Col) {
+					best, ok = Cell{Row: i, Col: j}, true
+				}
+			}
+		}
+
+		if !ok {
+			return
+		}
+
+		s.allocate(best)
+	}
+}
+
+// vogel allocates to the cheapest route of the row or column with the
+// largest penalty, the penalty is the difference of two cheapest routes.
+func (s *state) vogel() {
+	for {
+		best, penalty, ok := Cell{}, -1., false
+		for i := range s.supply {
+			if c, p, found := s.penalty(i, true); found && p > penalty {
+				best, penalty, ok = c, p, true
+			}
+		}
+
+		for j := range s.demand {
+			if c, p, found := s.penalty(j, false); found && p > penalty {
+				best, penalty, ok = c, p, true
+			}
+		}
+
+		if !ok {
+			return
+		}
+
+		s.allocate(best)
+	}
+}
+
+func (s *state) penalty(line int, isRow bool) (Cell, float64, bool) {
+	n := len(s.demand)
+	if !isRow {
+		n = len(s.supply)
+	}
+
+	var (
+		cheapest      Cell
+		first, second = math.Inf(1), math.Inf(1)
+		found         bool
+	)
+
+	for k := range n {
+		c := Cell{Row: line, Col: k}
+		if !isRow {
+			c = Cell{Row: k, Col: line}
+		}
+
+		if !s.isOpen(c.Row, c.Col) {
+			continue
+		}
+
+		found = true
+		switch cost := s.cost(c.Row, c.Col); {
+		case cost < first:
+			cheapest, first, second = c, cost, first
+		case cost < second:
+			second = cost
+		}
+	}
+
+	if !found {
+		return Cell{}, 0, false
+	}
+
+	if math.IsInf(second, 1) {
+		return cheapest, first, true
+	}
+
+	return cheapest, second - first, true
+}
+
+func (s *state) isOpen(i, j int) bool {
+	return s.supply[i] > eps && s.demand[j] > eps
+}
+
+func (s *state) cost(i, j int) float64 {
+	return s.costs.Rows[i][j]
+}
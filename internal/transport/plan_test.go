@@ -0,0 +1,111 @@
+package transport
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func textbook() Problem {
+	return Problem{
+		Supply: []float64{6, 1, 10},
+		Demand: []float64{7, 5, 3, 2},
+		Cost: matrix.Matrix{Rows: []matrix.Row{
+			{2, 3, 11, 7},
+			{1, 0, 6, 1},
+			{5, 8, 15, 9},
+		}},
+	}
+}
+
+func TestInitialPlan(t *testing.T) {
+	tc := []struct {
+		name     string
+		method   Method
+		amounts  []matrix.Row
+		cost     float64
+		expected error
+	}{
+		{
+			name:   "Should build northwest corner plan",
+			method: NorthwestCorner,
+			amounts: []matrix.Row{
+				{6, 0, 0, 0},
+				{1, 0, 0, 0},
+				{0, 5, 3, 2},
+			},
+			cost: 116,
+		},
+		{
+			name:   "Should build minimum cost plan",
+			method: MinimumCost,
+			amounts: []matrix.Row{
+				{6, 0, 0, 0},
+				{0, 1, 0, 0},
+				{1, 4, 3, 2},
+			},
+			cost: 112,
+		},
+		{
+			name:   "Should build Vogel plan",
+			method: Vogel,
+			amounts: []matrix.Row{
+				{1, 5, 0, 0},
+				{0, 0, 0, 1},
+				{6, 0, 3, 1},
+			},
+			cost: 102,
+		},
+		{
+			name:     "Should reject unknown method",
+			method:   Method(42),
+			expected: ErrUnknownMethod,
+		},
+	}
+
+	b, err := Balance(textbook())
+	if err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := InitialPlan(b, tt.method)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.expected, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(got.Amounts.Rows, tt.amounts) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.amounts, got.Amounts.Rows)
+			}
+
+			if got.Cost != tt.cost {
+				t.Fatalf("Expected to get: %v, got: %v", tt.cost, got.Cost)
+			}
+
+			if n := basisSize(got); n != len(b.Supply)+len(b.Demand)-1 {
+				t.Fatalf("Expected basis to have %d cells, got: %d", len(b.Supply)+len(b.Demand)-1, n)
+			}
+		})
+	}
+}
+
+func basisSize(p Plan) int {
+	var n int
+	for _, row := range p.Basis {
+		for _, basic := range row {
+			if basic {
+				n++
+			}
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,105 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+// eps compares the amounts which are left after subtractions.
+const eps = 1e-9
+
+var (
+	ErrEmptyProblem   = errors.New("problem should have at least one supplier and one consumer")
+	ErrNegativeAmount = errors.New("supply and demand should not be negative")
+	ErrInvalidCost    = errors.New("cost should be a finite number")
+	ErrUnknownMethod  = errors.New("unknown initial plan method")
+)
+
+// Problem ships Supply[i] units from every supplier to consumers which
+// need Demand[j] units, shipping one unit costs Cost.Rows[i][j].
+type Problem struct {
+	Supply []float64
+	Demand []float64
+	Cost   matrix.Matrix
+}
+
+func (p Problem) Validate() error {
+	if len(p.Supply) == 0 || len(p.Demand) == 0 {
+		return ErrEmptyProblem
+	}
+
+	if len(p.Cost.Rows) != len(p.Supply) {
+		return fmt.Errorf("cost should have %d rows, got %d", len(p.Supply), len(p.Cost.Rows))
+	}
+
+	for i, row := range p.Cost.Rows {
+		if len(row) != len(p.Demand) {
+			return fmt.Errorf("cost row %d should have %d columns, got %d", i+1, len(p.Demand), len(row))
+		}
+
+		for _, c := range row {
+			if math.IsNaN(c) || math.IsInf(c, 0) {
+				return ErrInvalidCost
+			}
+		}
+	}
+
+	for _, v := range append(append([]float64(nil), p.Supply...), p.Demand...) {
+		if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+			return ErrNegativeAmount
+		}
+	}
+
+	return nil
+}
+
+// Balanced is the problem with equal total supply and demand. The
+// surplus goes to the dummy consumer, the shortage comes from the dummy
+// supplier, both with zero cost.
+type Balanced struct {
+	Problem
+	DummySupplier bool
+	DummyConsumer bool
+}
+
+func Balance(p Problem) (Balanced, error) {
+	if err := p.Validate(); err != nil {
+		return Balanced{}, err
+	}
+
+	b := Balanced{
+		Problem: Problem{
+			Supply: append([]float64(nil), p.Supply...),
+			Demand: append([]float64(nil), p.Demand...),
+			Cost:   p.Cost.Copy(),
+		},
+	}
+
+	supply, demand := sum(p.Supply), sum(p.Demand)
+	switch {
+	case supply-demand > eps:
+		b.DummyConsumer = true
+		b.Demand = append(b.Demand, supply-demand)
+		for i := range b.Cost.Rows {
+			b.Cost.Rows[i] = append(b.Cost.Rows[i], 0)
+		}
+	case demand-supply > eps:
+		b.DummySupplier = true
+		b.Supply = append(b.Supply, demand-supply)
+		b.Cost.Rows = append(b.Cost.Rows, make(matrix.Row, len(b.Demand)))
+	}
+
+	return b, nil
+}
+
+func sum(r []float64) float64 {
+	var res float64
+	for _, v := range r {
+		res += v
+	}
+
+	return res
+}
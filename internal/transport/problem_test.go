@@ -0,0 +1,150 @@
+package transport
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestValidate(t *testing.T) {
+	tc := []struct {
+		name    string
+		problem Problem
+		err     error
+		hasErr  bool
+	}{
+		{
+			name: "Should accept valid problem",
+			problem: Problem{
+				Supply: []float64{1, 2},
+				Demand: []float64{3},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{1}, {2}}},
+			},
+		},
+		{
+			name: "Should reject empty problem",
+			problem: Problem{
+				Demand: []float64{3},
+			},
+			err: ErrEmptyProblem,
+		},
+		{
+			name: "Should reject negative supply",
+			problem: Problem{
+				Supply: []float64{-1},
+				Demand: []float64{3},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{1}}},
+			},
+			err: ErrNegativeAmount,
+		},
+		{
+			name: "Should reject infinite cost",
+			problem: Problem{
+				Supply: []float64{1},
+				Demand: []float64{3},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{math.Inf(1)}}},
+			},
+			err: ErrInvalidCost,
+		},
+		{
+			name: "Should reject cost of wrong size",
+			problem: Problem{
+				Supply: []float64{1},
+				Demand: []float64{3, 4},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{1}}},
+			},
+			hasErr: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.problem.Validate()
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if tt.err == nil && (err != nil) != tt.hasErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.hasErr, err)
+			}
+		})
+	}
+}
+
+func TestBalance(t *testing.T) {
+	tc := []struct {
+		name     string
+		problem  Problem
+		expected Balanced
+	}{
+		{
+			name: "Should keep balanced problem",
+			problem: Problem{
+				Supply: []float64{5, 5},
+				Demand: []float64{10},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{1}, {2}}},
+			},
+			expected: Balanced{
+				Problem: Problem{
+					Supply: []float64{5, 5},
+					Demand: []float64{10},
+					Cost:   matrix.Matrix{Rows: []matrix.Row{{1}, {2}}},
+				},
+			},
+		},
+		{
+			name: "Should add dummy consumer",
+			problem: Problem{
+				Supply: []float64{5, 5},
+				Demand: []float64{4},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{1}, {2}}},
+			},
+			expected: Balanced{
+				Problem: Problem{
+					Supply: []float64{5, 5},
+					Demand: []float64{4, 6},
+					Cost:   matrix.Matrix{Rows: []matrix.Row{{1, 0}, {2, 0}}},
+				},
+				DummyConsumer: true,
+			},
+		},
+		{
+			name: "Should add dummy supplier",
+			problem: Problem{
+				Supply: []float64{5},
+				Demand: []float64{4, 3},
+				Cost:   matrix.Matrix{Rows: []matrix.Row{{1, 2}}},
+			},
+			expected: Balanced{
+				Problem: Problem{
+					Supply: []float64{5, 2},
+					Demand: []float64{4, 3},
+					Cost:   matrix.Matrix{Rows: []matrix.Row{{1, 2}, {0, 0}}},
+				},
+				DummySupplier: true,
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Balance(tt.problem)
+			if err != nil {
+				t.Fatalf("Expected to get no error, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("Expected to get: %+v, got: %+v", tt.expected, got)
+			}
+
+			if len(tt.problem.Cost.Rows[0]) != len(tt.problem.Demand) {
+				t.Fatal("Expected the original cost to stay intact")
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package workspace
+
+import (
+	"github.com/hrvadl/algo/internal/lp"
+)
+
+const (
+	MaxGoal = string(lp.Maximize)
+	MinGoal = string(lp.Minimize)
+)
+
+var ErrInvalidGoal = lp.ErrInvalidSense
+
+// Problem parses the expressions of the model together with the
+// bounds of its variables.
+func (model Model) Problem() (lp.Problem, error) {
+	p, err := lp.Parse(model.Goal, model.Objective, model.Constraints)
+	if err != nil {
+		return lp.Problem{}, err
+	}
+
+	for _, b := range model.Bounds {
+		if err := p.ParseBound(b); err != nil {
+			return lp.Problem{}, err
+		}
+	}
+
+	return p, nil
+}
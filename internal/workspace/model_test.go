@@ -0,0 +1,58 @@
+package workspace
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/lp"
+)
+
+func TestModelProblem(t *testing.T) {
+	tc := []struct {
+		name   string
+		model  Model
+		bounds []lp.Bound
+		err    error
+	}{
+		{
+			name:  "Should leave variables non-negative",
+			model: Model{Objective: "x1+x2", Constraints: []string{"x1+x2<=4"}, Goal: MaxGoal},
+		},
+		{
+			name: "Should apply bounds",
+			model: Model{
+				Objective:   "x1+x2",
+				Constraints: []string{"x1+x2<=4"},
+				Bounds:      []string{"x1 <= 3", "x2 free"},
+				Goal:        MaxGoal,
+			},
+			bounds: []lp.Bound{{Lower: 0, Upper: 3}, {Lower: math.Inf(-1), Upper: math.Inf(1)}},
+		},
+		{
+			name: "Should reject invalid bound",
+			model: Model{
+				Objective:   "x1+x2",
+				Constraints: []string{"x1+x2<=4"},
+				Bounds:      []string{"x1 < 3"},
+				Goal:        MinGoal,
+			},
+			err: lp.ErrInvalidBoundSyntax,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := tt.model.Problem()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if err == nil && !reflect.DeepEqual(p.Bounds, tt.bounds) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.bounds, p.Bounds)
+			}
+		})
+	}
+}
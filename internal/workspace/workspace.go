@@ -0,0 +1,231 @@
+package workspace
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"unicode"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+var (
+	ErrNotFound    = errors.New("variable is not defined")
+	ErrInvalidName = errors.New("variable name should start with a letter and contain only letters, digits and _")
+	ErrEmptyMatrix = errors.New("matrix should have at least one row and one column")
+	ErrRaggedRows  = errors.New("matrix rows should have the same length")
+)
+
+type Kind = string
+
+const (
+	MatrixKind Kind = "matrix"
+	ModelKind  Kind = "lp"
+)
+
+type Model struct {
+	Objective   string   `json:"objective"`
+	Constraints []string `json:"constraints"`
+	Bounds      []string `json:"bounds,omitempty"`
+	Goal        string   `json:"goal"`
+}
+
+type Workspace struct {
+	matrices map[string]matrix.Matrix
+	models   map[string]Model
+}
+
+type file struct {
+	Matrices map[string][]matrix.Row `json:"matrices"`
+	Models   map[string]Model        `json:"models"`
+}
+
+func New() *Workspace {
+	return &Workspace{
+		matrices: make(map[string]matrix.Matrix),
+		models:   make(map[string]Model),
+	}
+}
+
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+
+	for i, r := range name {
+		if i == 0 && !unicode.IsLetter(r) {
+			return fmt.Errorf("%w: %q", ErrInvalidName, name)
+		}
+
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return fmt.Errorf("%w: %q", ErrInvalidName, name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateMatrix checks that the matrix is not empty and rectangular,
+// the handlers index the first row and assume the same length of others.
+func ValidateMatrix(m matrix.Matrix) error {
+	if len(m.Rows) == 0 || len(m.Rows[0]) == 0 {
+		return ErrEmptyMatrix
+	}
+
+	for i, row := range m.Rows {
+		if len(row) != len(m.Rows[0]) {
+			return fmt.Errorf("%w: row %d has %d elements, expected: %d", ErrRaggedRows, i+1, len(row), len(m.Rows[0]))
+		}
+	}
+
+	return nil
+}
+
+func (w *Workspace) SetMatrix(name string, m matrix.Matrix) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+
+	if err := ValidateMatrix(m); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	delete(w.models, name)
+	w.matrices[name] = m.Copy()
+	return nil
+}
+
+func (w *Workspace) Matrix(name string) (matrix.Matrix, error) {
+	m, ok := w.matrices[name]
+	if !ok {
+		return matrix.Matrix{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return m.Copy(), nil
+}
+
+func (w *Workspace) SetModel(name string, m Model) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+
+	if m.Goal != MaxGoal && m.Goal != MinGoal {
+		return fmt.Errorf("%s: %w", name, ErrInvalidGoal)
+	}
+
+	delete(w.matrices, name)
+	m.Constraints = slices.Clone(m.Constraints)
+	m.Bounds = slices.Clone(m.Bounds)
+	w.models[name] = m
+	return nil
+}
+
+func (w *Workspace) Model(name string) (Model, error) {
+	m, ok := w.models[name]
+	if !ok {
+		return Model{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	m.Constraints = slices.Clone(m.Constraints)
+	m.Bounds = slices.Clone(m.Bounds)
+	return m, nil
+}
+
+func (w *Workspace) Kind(name string) (Kind, error) {
+	if _, ok := w.matrices[name]; ok {
+		return MatrixKind, nil
+	}
+
+	if _, ok := w.models[name]; ok {
+		return ModelKind, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+func (w *Workspace) Delete(name string) error {
+	if _, err := w.Kind(name); err != nil {
+		return err
+	}
+
+	delete(w.matrices, name)
+	delete(w.models, name)
+	return nil
+}
+
+func (w *Workspace) Names() []string {
+	res := make([]string, 0, len(w.matrices)+len(w.models))
+	for name := range w.matrices {
+		res = append(res, name)
+	}
+
+	for name := range w.models {
+		res = append(res, name)
+	}
+
+	slices.Sort(res)
+	return res
+}
+
+func (w *Workspace) Save(path string) error {
+	f := file{
+		Matrices: make(map[string][]matrix.Row, len(w.matrices)),
+		Models:   w.models,
+	}
+
+	for name, m := range w.matrices {
+		f.Matrices[name] = m.Rows
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		return fmt.Errorf("cannot encode workspace: %w", err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("cannot save workspace: %w", err)
+	}
+
+	return nil
+}
+
+// Load merges variables from the file into the workspace, overriding
+// the variables with the same name. Every variable is checked before
+// the workspace is changed, so a broken file doesn't load partially.
+func (w *Workspace) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("cannot load workspace: %w", err)
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("cannot decode workspace: %w", err)
+	}
+
+	staged := &Workspace{
+		matrices: maps.Clone(w.matrices),
+		models:   maps.Clone(w.models),
+	}
+
+	for name, rows := range f.Matrices {
+		if err := staged.SetMatrix(name, matrix.Matrix{Rows: rows}); err != nil {
+			return fmt.Errorf("cannot load workspace: %w", err)
+		}
+	}
+
+	for name, m := range f.Models {
+		if err := staged.SetModel(name, m); err != nil {
+			return fmt.Errorf("cannot load workspace: %w", err)
+		}
+	}
+
+	w.matrices, w.models = staged.matrices, staged.models
+	return nil
+}
@@ -0,0 +1,188 @@
+package workspace
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hrvadl/algo/internal/matrix"
+)
+
+func TestValidateName(t *testing.T) {
+	tc := []struct {
+		name    string
+		varName string
+		wantErr bool
+	}{
+		{name: "Should accept letter", varName: "A"},
+		{name: "Should accept letters with digits", varName: "cost_2"},
+		{name: "Should reject empty name", varName: "", wantErr: true},
+		{name: "Should reject name starting with digit", varName: "2A", wantErr: true},
+		{name: "Should reject name with symbols", varName: "A-B", wantErr: true},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := ValidateName(tt.varName); (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWorkspace(t *testing.T) {
+	w := New()
+	a := matrix.Matrix{Rows: []matrix.Row{{1, 2}, {3, 4}}}
+	if err := w.SetMatrix("A", a); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := w.Matrix("A")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got.Rows[0][0] = 100
+	if stored, _ := w.Matrix("A"); stored.Rows[0][0] != 1 {
+		t.Fatalf("Expected workspace to keep its own copy of the matrix")
+	}
+
+	p := Model{Objective: "x1+x2", Constraints: []string{"x1<=3"}, Goal: "max"}
+	if err := w.SetModel("P", p); err != nil {
+		t.Fatal(err)
+	}
+
+	if names := w.Names(); !slices.Equal(names, []string{"A", "P"}) {
+		t.Fatalf("Expected to get: %v, got: %v", []string{"A", "P"}, names)
+	}
+
+	if err := w.SetModel("A", p); err != nil {
+		t.Fatal(err)
+	}
+
+	if kind, _ := w.Kind("A"); kind != ModelKind {
+		t.Fatalf("Expected to get: %v, got: %v", ModelKind, kind)
+	}
+
+	if err := w.Delete("A"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := w.Model("A"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected to get: %v, got: %v", ErrNotFound, err)
+	}
+}
+
+func TestWorkspaceSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "workspace.json")
+	w := New()
+	a := matrix.Matrix{Rows: []matrix.Row{{1, -2.5}, {3, 4}}}
+	p := Model{
+		Objective:   "2x1+3x2",
+		Constraints: []string{"x1+x2<=4", "x1-x2=1"},
+		Bounds:      []string{"x1 <= 3"},
+		Goal:        "min",
+	}
+
+	if err := w.SetMatrix("A", a); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.SetModel("P", p); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := New()
+	if err := loaded.Load(path); err != nil {
+		t.Fatal(err)
+	}
+
+	gotA, err := loaded.Matrix("A")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(gotA.Rows, a.Rows) {
+		t.Fatalf("Expected to get: %v, got: %v", a.Rows, gotA.Rows)
+	}
+
+	gotP, err := loaded.Model("P")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(gotP, p) {
+		t.Fatalf("Expected to get: %v, got: %v", p, gotP)
+	}
+}
+
+func TestWorkspaceLoadInvalid(t *testing.T) {
+	tc := []struct {
+		name     string
+		contents string
+		err      error
+	}{
+		{
+			name:     "Should reject ragged matrix",
+			contents: `{"matrices":{"B":[[1,2],[3]]}}`,
+			err:      ErrRaggedRows,
+		},
+		{
+			name:     "Should reject empty matrix",
+			contents: `{"matrices":{"B":[]}}`,
+			err:      ErrEmptyMatrix,
+		},
+		{
+			name:     "Should reject matrix with empty rows",
+			contents: `{"matrices":{"B":[[]]}}`,
+			err:      ErrEmptyMatrix,
+		},
+		{
+			name:     "Should reject invalid name after valid entries",
+			contents: `{"matrices":{"A":[[5]],"B":[[6]]},"models":{"1P":{"objective":"x1","goal":"max"}}}`,
+			err:      ErrInvalidName,
+		},
+		{
+			name:     "Should reject model with invalid goal",
+			contents: `{"models":{"P":{"objective":"x1","goal":"maximum"}}}`,
+			err:      ErrInvalidGoal,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "workspace.json")
+			if err := os.WriteFile(path, []byte(tt.contents), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			w := New()
+			a := matrix.Matrix{Rows: []matrix.Row{{1, 2}}}
+			if err := w.SetMatrix("A", a); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := w.Load(path); !errors.Is(err, tt.err) {
+				t.Fatalf("Expected to get error: %v, got: %v", tt.err, err)
+			}
+
+			if names := w.Names(); !reflect.DeepEqual(names, []string{"A"}) {
+				t.Fatalf("Expected to get: %v, got: %v", []string{"A"}, names)
+			}
+
+			got, err := w.Matrix("A")
+			if err != nil || !reflect.DeepEqual(got.Rows, a.Rows) {
+				t.Fatalf("Expected to get: %v, got: %v", a.Rows, got.Rows)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Package command is the registry of the REPL commands. Packages outside
+// of the module add their commands to Default with Register from init,
+// the binary picks them up once it imports the package.
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type Handler = func(ctx context.Context, args []string)
+
+type Arg struct {
+	Name     string
+	Help     string
+	Optional bool
+	// Rest consumes every remaining token, it is only allowed as the last argument.
+	Rest bool
+}
+
+type Command struct {
+	Name    string
+	Aliases []string
+	Help    string
+	Args    []Arg
+	Handler Handler
+}
+
+func (c Command) Usage() string {
+	var b strings.Builder
+	b.WriteString(c.Name)
+	for _, a := range c.Args {
+		name := a.Name
+		if a.Rest {
+			name += "..."
+		}
+
+		if a.Optional {
+			fmt.Fprintf(&b, " [%s]", name)
+		} else {
+			fmt.Fprintf(&b, " <%s>", name)
+		}
+	}
+	return b.String()
+}
+
+func (c Command) ValidateArgs(args []string) error {
+	var required int
+	for _, a := range c.Args {
+		if !a.Optional {
+			required++
+		}
+	}
+
+	max := len(c.Args)
+	if max > 0 && c.Args[max-1].Rest {
+		max = len(args)
+	}
+
+	if len(args) < required || len(args) > max {
+		return fmt.Errorf("invalid amount of arguments, usage: %s", c.Usage())
+	}
+
+	return nil
+}
+
+// Default is the registry the REPL dispatches the lines to.
+var Default = NewRegistry()
+
+func Register(c Command) error {
+	return Default.Register(c)
+}
+
+func MustRegister(c Command) {
+	Default.MustRegister(c)
+}
+
+type Registry struct {
+	commands []Command
+	index    map[string]int
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		index: make(map[string]int),
+	}
+}
+
+func (r *Registry) Register(c Command) error {
+	if c.Name == "" {
+		return errors.New("command name should not be empty")
+	}
+
+	if c.Handler == nil {
+		return fmt.Errorf("command %s should have a handler", c.Name)
+	}
+
+	for i, a := range c.Args {
+		if a.Rest && i != len(c.Args)-1 {
+			return fmt.Errorf("command %s can have only the last rest argument", c.Name)
+		}
+	}
+
+	names := append([]string{c.Name}, c.Aliases...)
+	for _, name := range names {
+		if strings.ContainsFunc(name, isSpace) {
+			return fmt.Errorf("command name %q should not contain spaces", name)
+		}
+
+		if _, ok := r.index[name]; ok {
+			return fmt.Errorf("command %s is already registered", name)
+		}
+	}
+
+	r.commands = append(r.commands, c)
+	for _, name := range names {
+		r.index[name] = len(r.commands) - 1
+	}
+
+	return nil
+}
+
+func (r *Registry) MustRegister(c Command) {
+	if err := r.Register(c); err != nil {
+		panic(err)
+	}
+}
+
+func (r *Registry) Lookup(name string) (Command, bool) {
+	idx, ok := r.index[name]
+	if !ok {
+		return Command{}, false
+	}
+	return r.commands[idx], true
+}
+
+func (r *Registry) Commands() []Command {
+	res := make([]Command, len(r.commands))
+	copy(res, r.commands)
+	return res
+}
+
+func (r *Registry) Parse(line string) (Command, []string, error) {
+	tokens := strings.Fields(line)
+	if len(tokens) == 0 {
+		return Command{}, nil, errors.New("invalid input")
+	}
+
+	c, ok := r.Lookup(tokens[0])
+	if !ok {
+		return Command{}, nil, errors.New("invalid options is chosen")
+	}
+
+	args := tokens[1:]
+	if err := c.ValidateArgs(args); err != nil {
+		return Command{}, nil, err
+	}
+
+	return c, args, nil
+}
+
+func (r *Registry) Dispatch(ctx context.Context, line string) error {
+	c, args, err := r.Parse(line)
+	if err != nil {
+		return err
+	}
+
+	c.Handler(ctx, args)
+	return nil
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n'
+}
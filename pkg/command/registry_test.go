@@ -0,0 +1,178 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestRegistryRegister(t *testing.T) {
+	noop := func(context.Context, []string) {}
+	tc := []struct {
+		name     string
+		commands []Command
+		wantErr  bool
+	}{
+		{
+			name: "Should register commands correctly",
+			commands: []Command{
+				{Name: "first", Aliases: []string{"f"}, Handler: noop},
+				{Name: "second", Handler: noop},
+			},
+		},
+		{
+			name: "Should reject duplicate name",
+			commands: []Command{
+				{Name: "first", Handler: noop},
+				{Name: "first", Handler: noop},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should reject alias clashing with name",
+			commands: []Command{
+				{Name: "first", Handler: noop},
+				{Name: "second", Aliases: []string{"first"}, Handler: noop},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should reject command without handler",
+			commands: []Command{
+				{Name: "first"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should reject empty name",
+			commands: []Command{
+				{Handler: noop},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := NewRegistry()
+			var err error
+			for _, c := range tt.commands {
+				if err = r.Register(c); err != nil {
+					break
+				}
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRegistryDispatch(t *testing.T) {
+	tc := []struct {
+		name     string
+		line     string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "Should dispatch by name",
+			line:     "cmd",
+			expected: []string{},
+		},
+		{
+			name:     "Should dispatch by alias with args",
+			line:     "  c   A  ",
+			expected: []string{"A"},
+		},
+		{
+			name:    "Should reject unknown command",
+			line:    "unknown",
+			wantErr: true,
+		},
+		{
+			name:    "Should reject too many args",
+			line:    "cmd A B",
+			wantErr: true,
+		},
+		{
+			name:    "Should reject empty line",
+			line:    "   ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			r := NewRegistry()
+			r.MustRegister(Command{
+				Name:    "cmd",
+				Aliases: []string{"c"},
+				Args:    []Arg{{Name: "matrix", Optional: true}},
+				Handler: func(_ context.Context, args []string) { got = args },
+			})
+
+			err := r.Dispatch(context.Background(), tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if !slices.Equal(got, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCommandUsage(t *testing.T) {
+	c := Command{
+		Name: "cmd",
+		Args: []Arg{
+			{Name: "a"},
+			{Name: "b", Optional: true, Rest: true},
+		},
+	}
+
+	if got, expected := c.Usage(), "cmd <a> [b...]"; got != expected {
+		t.Fatalf("Expected to get: %v, got: %v", expected, got)
+	}
+}
+
+func TestCommandValidateRestArgs(t *testing.T) {
+	c := Command{
+		Name: "let",
+		Args: []Arg{
+			{Name: "name"},
+			{Name: "value", Optional: true, Rest: true},
+		},
+	}
+
+	for _, args := range [][]string{{"A"}, {"A", "=", "[1", "2]"}} {
+		if err := c.ValidateArgs(args); err != nil {
+			t.Fatalf("Expected args %v to be valid, got: %v", args, err)
+		}
+	}
+
+	if err := c.ValidateArgs(nil); err == nil {
+		t.Fatal("Expected missing required argument to be invalid")
+	}
+}
+
+func TestRegisterDefault(t *testing.T) {
+	c := Command{Name: "external", Handler: func(context.Context, []string) {}}
+	if err := Register(c); err != nil {
+		t.Fatalf("Expected to get no error, got: %v", err)
+	}
+
+	if _, ok := Default.Lookup(c.Name); !ok {
+		t.Fatalf("Expected command %s to be registered", c.Name)
+	}
+
+	if err := Register(c); err == nil {
+		t.Fatal("Expected second registration to fail")
+	}
+}
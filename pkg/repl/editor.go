@@ -0,0 +1,311 @@
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const maxUndo = 100
+
+const (
+	keyCtrlA     = 0x01
+	keyCtrlB     = 0x02
+	keyCtrlC     = 0x03
+	keyCtrlD     = 0x04
+	keyCtrlE     = 0x05
+	keyCtrlF     = 0x06
+	keyBackspace = 0x08
+	keyTab       = 0x09
+	keyLF        = 0x0a
+	keyCtrlK     = 0x0b
+	keyCR        = 0x0d
+	keyCtrlN     = 0x0e
+	keyCtrlP     = 0x10
+	keyCtrlU     = 0x15
+	keyCtrlW     = 0x17
+	keyCtrlZ     = 0x1a
+	keyEscape    = 0x1b
+	keyCtrlUnder = 0x1f
+	keyDelete    = 0x7f
+)
+
+var ErrInterrupted = errors.New("interrupted")
+
+// Completer receives the text before the cursor and returns the
+// candidates that should replace it.
+type Completer = func(prefix string) []string
+
+type Editor struct {
+	in        *bufio.Reader
+	out       io.Writer
+	History   *History
+	Completer Completer
+}
+
+func NewEditor(in *bufio.Reader, out io.Writer) *Editor {
+	return &Editor{
+		in:      in,
+		out:     out,
+		History: NewHistory(DefaultHistorySize),
+	}
+}
+
+type snapshot struct {
+	buf []rune
+	pos int
+}
+
+type line struct {
+	prompt  string
+	buf     []rune
+	pos     int
+	undo    []snapshot
+	hist    int
+	pending []rune
+	tabbed  bool
+}
+
+// ReadLine expects the terminal to be in raw mode. The initial text is
+// placed into the buffer so it can be edited before submitting.
+func (e *Editor) ReadLine(prompt, initial string) (string, error) {
+	l := line{
+		prompt: prompt,
+		buf:    []rune(initial),
+		hist:   e.History.Len(),
+	}
+	l.pos = len(l.buf)
+	e.refresh(&l)
+
+	for {
+		r, _, err := e.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+
+		tabbed := r == keyTab
+		switch r {
+		case keyCR, keyLF:
+			fmt.Fprint(e.out, "\r\n")
+			return string(l.buf), nil
+		case keyCtrlC:
+			fmt.Fprint(e.out, "^C\r\n")
+			return "", ErrInterrupted
+		case keyCtrlD:
+			if len(l.buf) == 0 {
+				fmt.Fprint(e.out, "\r\n")
+				return "", io.EOF
+			}
+			l.deleteAt(l.pos)
+		case keyBackspace, keyDelete:
+			l.deleteAt(l.pos - 1)
+		case keyCtrlA:
+			l.pos = 0
+		case keyCtrlE:
+			l.pos = len(l.buf)
+		case keyCtrlB:
+			l.move(-1)
+		case keyCtrlF:
+			l.move(1)
+		case keyCtrlK:
+			l.save()
+			l.buf = l.buf[:l.pos]
+		case keyCtrlU:
+			l.save()
+			l.buf = slices.Clone(l.buf[l.pos:])
+			l.pos = 0
+		case keyCtrlW:
+			l.deleteWord()
+		case keyCtrlP:
+			e.historyStep(&l, -1)
+		case keyCtrlN:
+			e.historyStep(&l, 1)
+		case keyCtrlZ, keyCtrlUnder:
+			l.restore()
+		case keyTab:
+			e.complete(&l)
+		case keyEscape:
+			if err := e.escape(&l); err != nil {
+				return "", err
+			}
+		default:
+			if unicode.IsPrint(r) {
+				l.insert(r)
+			}
+		}
+
+		l.tabbed = tabbed
+		e.refresh(&l)
+	}
+}
+
+func (e *Editor) escape(l *line) error {
+	b, err := e.in.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	if b != '[' && b != 'O' {
+		return nil
+	}
+
+	var seq []byte
+	for {
+		b, err = e.in.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		seq = append(seq, b)
+		if b >= 0x40 && b <= 0x7e {
+			break
+		}
+	}
+
+	switch string(seq) {
+	case "A":
+		e.historyStep(l, -1)
+	case "B":
+		e.historyStep(l, 1)
+	case "C":
+		l.move(1)
+	case "D":
+		l.move(-1)
+	case "H", "1~", "7~":
+		l.pos = 0
+	case "F", "4~", "8~":
+		l.pos = len(l.buf)
+	case "3~":
+		l.deleteAt(l.pos)
+	}
+
+	return nil
+}
+
+func (e *Editor) historyStep(l *line, step int) {
+	next := l.hist + step
+	if next < 0 || next > e.History.Len() {
+		return
+	}
+
+	if l.hist == e.History.Len() {
+		l.pending = slices.Clone(l.buf)
+	}
+
+	l.save()
+	l.hist = next
+	if next == e.History.Len() {
+		l.buf = slices.Clone(l.pending)
+	} else {
+		l.buf = []rune(e.History.At(next))
+	}
+	l.pos = len(l.buf)
+}
+
+func (e *Editor) complete(l *line) {
+	if e.Completer == nil {
+		return
+	}
+
+	prefix := string(l.buf[:l.pos])
+	candidates := e.Completer(prefix)
+	if len(candidates) == 0 {
+		return
+	}
+
+	common := commonPrefix(candidates)
+	if len(common) > len(prefix) {
+		l.save()
+		rest := l.buf[l.pos:]
+		l.buf = append([]rune(common), rest...)
+		l.pos = len([]rune(common))
+		return
+	}
+
+	if len(candidates) > 1 && l.tabbed {
+		fmt.Fprintf(e.out, "\r\n%s\r\n", strings.Join(candidates, "  "))
+	}
+}
+
+func (e *Editor) refresh(l *line) {
+	fmt.Fprintf(e.out, "\r%s%s\x1b[K", l.prompt, string(l.buf))
+	if back := len(l.buf) - l.pos; back > 0 {
+		fmt.Fprintf(e.out, "\x1b[%dD", back)
+	}
+}
+
+func (l *line) save() {
+	l.undo = append(l.undo, snapshot{buf: slices.Clone(l.buf), pos: l.pos})
+	if len(l.undo) > maxUndo {
+		l.undo = l.undo[1:]
+	}
+}
+
+func (l *line) restore() {
+	if len(l.undo) == 0 {
+		return
+	}
+
+	last := l.undo[len(l.undo)-1]
+	l.undo = l.undo[:len(l.undo)-1]
+	l.buf, l.pos = last.buf, last.pos
+}
+
+func (l *line) insert(r rune) {
+	l.save()
+	l.buf = slices.Insert(l.buf, l.pos, r)
+	l.pos++
+}
+
+func (l *line) deleteAt(pos int) {
+	if pos < 0 || pos >= len(l.buf) {
+		return
+	}
+
+	l.save()
+	l.buf = slices.Delete(l.buf, pos, pos+1)
+	if pos < l.pos {
+		l.pos--
+	}
+}
+
+func (l *line) deleteWord() {
+	start := l.pos
+	for start > 0 && l.buf[start-1] == ' ' {
+		start--
+	}
+	for start > 0 && l.buf[start-1] != ' ' {
+		start--
+	}
+
+	if start == l.pos {
+		return
+	}
+
+	l.save()
+	l.buf = slices.Delete(l.buf, start, l.pos)
+	l.pos = start
+}
+
+func (l *line) move(step int) {
+	if next := l.pos + step; next >= 0 && next <= len(l.buf) {
+		l.pos = next
+	}
+}
+
+func commonPrefix(s []string) string {
+	prefix := []rune(s[0])
+	for _, str := range s[1:] {
+		r := []rune(str)
+		n := 0
+		for n < len(prefix) && n < len(r) && prefix[n] == r[n] {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+	return string(prefix)
+}
@@ -0,0 +1,155 @@
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestEditorReadLine(t *testing.T) {
+	tc := []struct {
+		name     string
+		input    string
+		initial  string
+		history  []string
+		expected string
+		wantErr  error
+	}{
+		{
+			name:     "Should read plain line",
+			input:    "inverse\r",
+			expected: "inverse",
+		},
+		{
+			name:     "Should handle backspace",
+			input:    "rankk\x7f\r",
+			expected: "rank",
+		},
+		{
+			name:     "Should insert in the middle using arrows",
+			input:    "1 3\x1b[D2 \r",
+			expected: "1 2 3",
+		},
+		{
+			name:     "Should move to the line start",
+			input:    "2 3\x012 \x05 4\r",
+			expected: "2 2 3 4",
+		},
+		{
+			name:     "Should edit initial text",
+			initial:  "1 2 x",
+			input:    "\x7f3\r",
+			expected: "1 2 3",
+		},
+		{
+			name:     "Should undo last edits",
+			input:    "1 2\x1a\x1a3\r",
+			expected: "13",
+		},
+		{
+			name:     "Should kill word",
+			input:    "1 2 typo\x17\r",
+			expected: "1 2 ",
+		},
+		{
+			name:     "Should navigate history",
+			history:  []string{"inverse", "rank"},
+			input:    "\x1b[A\x1b[A\x1b[B\r",
+			expected: "rank",
+		},
+		{
+			name:     "Should restore pending line after history",
+			history:  []string{"inverse"},
+			input:    "ra\x1b[A\x1b[Bnk\r",
+			expected: "rank",
+		},
+		{
+			name:     "Should delete under cursor",
+			input:    "12\x1b[D\x1b[3~\r",
+			expected: "1",
+		},
+		{
+			name:    "Should interrupt on ctrl-c",
+			input:   "abc\x03",
+			wantErr: ErrInterrupted,
+		},
+		{
+			name:    "Should return EOF on ctrl-d with empty line",
+			input:   "\x04",
+			wantErr: io.EOF,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e := NewEditor(bufio.NewReader(strings.NewReader(tt.input)), io.Discard)
+			for _, h := range tt.history {
+				_ = e.History.Add(h)
+			}
+
+			got, err := e.ReadLine("> ", tt.initial)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if got != tt.expected {
+				t.Fatalf("Expected to get: %q, got: %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEditorComplete(t *testing.T) {
+	commands := []string{"game_strategies", "game_with_nature", "inverse"}
+	completer := func(prefix string) []string {
+		var res []string
+		for _, c := range commands {
+			if strings.HasPrefix(c, prefix) {
+				res = append(res, c+" ")
+			}
+		}
+		return res
+	}
+
+	tc := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Should complete single candidate",
+			input:    "inv\t\r",
+			expected: "inverse ",
+		},
+		{
+			name:     "Should complete common prefix",
+			input:    "ga\t\r",
+			expected: "game_",
+		},
+		{
+			name:     "Should keep line without candidates",
+			input:    "xyz\t\r",
+			expected: "xyz",
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e := NewEditor(bufio.NewReader(strings.NewReader(tt.input)), io.Discard)
+			e.Completer = completer
+
+			got, err := e.ReadLine("> ", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.expected {
+				t.Fatalf("Expected to get: %q, got: %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+const DefaultHistorySize = 1000
+
+type History struct {
+	entries []string
+	max     int
+	path    string
+}
+
+func NewHistory(max int) *History {
+	if max <= 0 {
+		max = DefaultHistorySize
+	}
+
+	return &History{max: max}
+}
+
+// LoadHistory reads the history from path and appends every new entry
+// to it. A missing file is not an error, it is created on the first Add.
+func LoadHistory(path string, max int) (*History, error) {
+	h := NewHistory(max)
+	h.path = path
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot open history: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			h.entries = append(h.entries, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read history: %w", err)
+	}
+
+	if len(h.entries) <= h.max {
+		return h, nil
+	}
+
+	h.entries = h.entries[len(h.entries)-h.max:]
+	return h, h.rewrite()
+}
+
+func (h *History) Add(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.ContainsAny(line, "\r\n") {
+		return nil
+	}
+
+	if n := len(h.entries); n > 0 && h.entries[n-1] == line {
+		return nil
+	}
+
+	h.entries = append(h.entries, line)
+	if len(h.entries) > h.max {
+		h.entries = h.entries[len(h.entries)-h.max:]
+	}
+
+	if h.path == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(h.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("cannot save history: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintln(f, line); err != nil {
+		return fmt.Errorf("cannot save history: %w", err)
+	}
+
+	return nil
+}
+
+func (h *History) Len() int {
+	return len(h.entries)
+}
+
+func (h *History) At(i int) string {
+	return h.entries[i]
+}
+
+func (h *History) Entries() []string {
+	res := make([]string, len(h.entries))
+	copy(res, h.entries)
+	return res
+}
+
+func (h *History) rewrite() error {
+	content := strings.Join(h.entries, "\n") + "\n"
+	if err := os.WriteFile(h.path, []byte(content), 0o600); err != nil {
+		return fmt.Errorf("cannot save history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package repl
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestHistoryAdd(t *testing.T) {
+	tc := []struct {
+		name     string
+		max      int
+		lines    []string
+		expected []string
+	}{
+		{
+			name:     "Should add lines correctly",
+			max:      10,
+			lines:    []string{"inverse", "rank"},
+			expected: []string{"inverse", "rank"},
+		},
+		{
+			name:     "Should skip empty and repeated lines",
+			max:      10,
+			lines:    []string{"inverse", "  ", "inverse", "rank"},
+			expected: []string{"inverse", "rank"},
+		},
+		{
+			name:     "Should keep only last entries",
+			max:      2,
+			lines:    []string{"a", "b", "c"},
+			expected: []string{"b", "c"},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := NewHistory(tt.max)
+			for _, l := range tt.lines {
+				if err := h.Add(l); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if got := h.Entries(); !slices.Equal(got, tt.expected) {
+				t.Fatalf("Expected to get: %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestHistoryPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history")
+
+	h, err := LoadHistory(path, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, l := range []string{"a", "b", "c"} {
+		if err := h.Add(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	loaded, err := LoadHistory(path, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"b", "c"}
+	if got := loaded.Entries(); !slices.Equal(got, expected) {
+		t.Fatalf("Expected to get: %v, got: %v", expected, got)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := string(content); got != "b\nc\n" {
+		t.Fatalf("Expected history file to be truncated, got: %q", got)
+	}
+}